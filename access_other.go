@@ -0,0 +1,44 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !(darwin || dragonfly || freebsd || linux || netbsd || openbsd)
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	"os"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Determines whether the given (existing) file or directory is writable by
+// the process, which, on this platform, is a best-effort determination: a
+// file is writable if its permissions include write permission for its
+// owner (i.e. it is not read-only); and a directory is always writable,
+// since its permissions do not reliably reflect whether files may be
+// created in it (as is the case on Windows).
+func path_access_writable_(path string) bool {
+
+	fi, err := os.Stat(path)
+	if err != nil {
+
+		return false
+	}
+
+	if fi.IsDir() {
+
+		return true
+	}
+
+	return 0 != (fi.Mode().Perm() & 0200)
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
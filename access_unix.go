@@ -0,0 +1,37 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	"syscall"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * constants
+ */
+
+const (
+	access_W_OK_ = 0x2 // The W_OK mode of access(2).
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Determines whether the given (existing) file or directory is writable by
+// the process, as determined by access(2), without modifying it.
+func path_access_writable_(path string) bool {
+
+	return nil == syscall.Access(path, access_W_OK_)
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
@@ -0,0 +1,259 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * types
+ */
+
+// Error type describing an option value, or a value, that is not valid,
+// e.g. that cannot be converted to the required type.
+type InvalidValueError struct {
+	Name   string // The name of the option, or value.
+	Value  string // The (invalid) value.
+	Reason string // The reason, e.g. "is not a valid integer".
+	Err    error  // The underlying error, if any.
+}
+
+func (e *InvalidValueError) Error() string {
+
+	return fmt.Sprintf("%s '%s' %s", e.Name, e.Value, e.Reason)
+}
+
+func (e *InvalidValueError) Unwrap() error {
+
+	return e.Err
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+func conversion_reason_(err error, what string) string {
+
+	if errors.Is(err, strconv.ErrRange) {
+
+		return "is out of range"
+	}
+
+	return "is not a valid " + what
+}
+
+// Converts the given string to a value of type T, which may be any of
+// string, bool, int, int64, uint, uint64, float64, time.Duration, []string,
+// or []int, where the slice types are obtained from a comma-separated list.
+func convert_value_[T any](name, s string) (value T, err error) {
+
+	invalid := func(e error, what string) error {
+
+		return &InvalidValueError{
+
+			Name:   name,
+			Value:  s,
+			Reason: conversion_reason_(e, what),
+			Err:    e,
+		}
+	}
+
+	switch p := any(&value).(type) {
+
+	case *string:
+
+		*p = s
+	case *bool:
+
+		if v, e := strconv.ParseBool(s); e != nil {
+
+			err = invalid(e, "boolean")
+		} else {
+
+			*p = v
+		}
+	case *int:
+
+		if v, e := strconv.ParseInt(s, 0, 0); e != nil {
+
+			err = invalid(e, "integer")
+		} else {
+
+			*p = int(v)
+		}
+	case *int64:
+
+		if v, e := strconv.ParseInt(s, 0, 64); e != nil {
+
+			err = invalid(e, "integer")
+		} else {
+
+			*p = v
+		}
+	case *uint:
+
+		if v, e := strconv.ParseUint(s, 0, 0); e != nil {
+
+			err = invalid(e, "non-negative integer")
+		} else {
+
+			*p = uint(v)
+		}
+	case *uint64:
+
+		if v, e := strconv.ParseUint(s, 0, 64); e != nil {
+
+			err = invalid(e, "non-negative integer")
+		} else {
+
+			*p = v
+		}
+	case *float64:
+
+		if v, e := strconv.ParseFloat(s, 64); e != nil {
+
+			err = invalid(e, "number")
+		} else {
+
+			*p = v
+		}
+	case *time.Duration:
+
+		if v, e := time.ParseDuration(s); e != nil {
+
+			err = invalid(e, "duration")
+		} else {
+
+			*p = v
+		}
+	case *[]string:
+
+		*p = strings.Split(s, ",")
+	case *[]int:
+
+		for _, part := range strings.Split(s, ",") {
+
+			if v, e := strconv.ParseInt(strings.TrimSpace(part), 0, 0); e != nil {
+
+				return value, invalid(e, "list of integers")
+			} else {
+
+				*p = append(*p, int(v))
+			}
+		}
+	default:
+
+		err = fmt.Errorf("%s: unsupported value type %T", name, value)
+	}
+
+	return
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Obtains the value of the option with the given id - name, or the
+// specification instance - converted to the type T, which may be any of
+// string, bool, int, int64, uint, uint64, float64, time.Duration,
+// []string, or []int.
+//
+// For the slice types, the values of all occurrences of the option are
+// combined, and each occurrence may itself be a comma-separated list.
+//
+// If the option is not found, found is false and err is nil; if it is
+// found but cannot be converted, err is an [*InvalidValueError].
+func OptionValue[T any](result Result, id any) (value T, found bool, err error) {
+
+	name := name_of_id_(id)
+
+	switch any(value).(type) {
+
+	case []string, []int:
+
+		var combined []string
+
+		for _, option := range result.Options {
+
+			if option.ResolvedName == name {
+
+				option.Use()
+
+				combined = append(combined, option.Value)
+			}
+		}
+
+		if 0 == len(combined) {
+
+			return
+		}
+
+		found = true
+		value, err = convert_value_[T](name, strings.Join(combined, ","))
+	default:
+
+		option, option_found := result.LookupOption(id)
+
+		if !option_found {
+
+			return
+		}
+
+		found = true
+		value, err = convert_value_[T](name, option.Value)
+	}
+
+	return
+}
+
+// Obtains the value of the option with the given id, as by [OptionValue],
+// returning the zero value of T if the option is not found. If the value
+// cannot be converted, a contingent report is issued, and the process
+// terminated, in the same manner as by [Result.Verify].
+func MustOptionValue[T any](result Result, id any) T {
+
+	value, _, err := OptionValue[T](result, id)
+
+	if err != nil {
+
+		result.abort_(err)
+	}
+
+	return value
+}
+
+// Reports the given error as a contingent report, to the error stream of
+// the result, and then exits via the exiter of the result.
+func (result Result) abort_(err error) {
+
+	stream := result.errStream
+	if stream == nil {
+
+		stream = os.Stderr
+	}
+
+	fmt.Fprintf(stream, "%s %v%s\n", result.prefix_(stream), err, uhs_(result.usageHelpSuffix))
+
+	if result.exiter != nil {
+
+		result.exiter.Exit(1)
+	} else {
+
+		os.Exit(1)
+	}
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
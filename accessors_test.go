@@ -0,0 +1,146 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func accessors_result_(t *testing.T, argv []string, options ...any) libclimate.Result {
+
+	climate, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddOption(clasp.Option("--port").SetHelp("Specifies the port"))
+		cl.AddOption(clasp.Option("--timeout").SetHelp("Specifies the timeout").SetDefaultValue("5s"))
+		cl.AddOption(clasp.Option("--ratio").SetHelp("Specifies the ratio"))
+		cl.AddOption(clasp.Option("--tag").SetHelp("Specifies a tag"), libclimate.AliasFlag_Repeatable)
+		cl.AddOption(clasp.Option("--debug").SetHelp("Specifies debug mode"))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	require.Nil(t, err)
+
+	r, err := climate.Parse(argv, options...)
+
+	require.Nil(t, err)
+
+	return r
+}
+
+func Test_OptionValue_int(t *testing.T) {
+
+	r := accessors_result_(t, []string{"bin/myapp", "--port=8080"})
+
+	port, found, err := libclimate.OptionValue[int](r, "--port")
+
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, 8080, port)
+}
+
+func Test_OptionValue_int_NOT_FOUND(t *testing.T) {
+
+	r := accessors_result_(t, []string{"bin/myapp"})
+
+	port, found, err := libclimate.OptionValue[int](r, "--port")
+
+	require.Nil(t, err)
+	require.False(t, found)
+	require.Equal(t, 0, port)
+}
+
+func Test_OptionValue_int_INVALID(t *testing.T) {
+
+	r := accessors_result_(t, []string{"bin/myapp", "--port=eighty"})
+
+	_, found, err := libclimate.OptionValue[int](r, "--port")
+
+	require.True(t, found)
+	require.NotNil(t, err)
+	require.Equal(t, "--port 'eighty' is not a valid integer", err.Error())
+
+	var ive *libclimate.InvalidValueError
+
+	require.True(t, errors.As(err, &ive))
+	require.Equal(t, "--port", ive.Name)
+	require.Equal(t, "eighty", ive.Value)
+}
+
+func Test_OptionValue_Duration_DEFAULT(t *testing.T) {
+
+	r := accessors_result_(t, []string{"bin/myapp"})
+
+	timeout, found, err := libclimate.OptionValue[time.Duration](r, "--timeout")
+
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, 5*time.Second, timeout)
+}
+
+func Test_OptionValue_float64_AND_bool(t *testing.T) {
+
+	r := accessors_result_(t, []string{"bin/myapp", "--ratio=0.25", "--debug=true"})
+
+	ratio, _, err := libclimate.OptionValue[float64](r, "--ratio")
+
+	require.Nil(t, err)
+	require.Equal(t, 0.25, ratio)
+
+	debug, _, err := libclimate.OptionValue[bool](r, "--debug")
+
+	require.Nil(t, err)
+	require.True(t, debug)
+}
+
+func Test_OptionValue_SliceOfString(t *testing.T) {
+
+	r := accessors_result_(t, []string{"bin/myapp", "--tag=a,b", "--tag=c"})
+
+	tags, found, err := libclimate.OptionValue[[]string](r, "--tag")
+
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, []string{"a", "b", "c"}, tags)
+}
+
+func Test_OptionValue_UNSUPPORTED_TYPE(t *testing.T) {
+
+	r := accessors_result_(t, []string{"bin/myapp", "--port=8080"})
+
+	_, _, err := libclimate.OptionValue[complex128](r, "--port")
+
+	require.NotNil(t, err)
+}
+
+func Test_MustOptionValue_1(t *testing.T) {
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r := accessors_result_(t, []string{"bin/myapp", "--port=8080"}, stm, exiter)
+
+	require.Equal(t, 8080, libclimate.MustOptionValue[int](r, "--port"))
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+}
+
+func Test_MustOptionValue_INVALID(t *testing.T) {
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r := accessors_result_(t, []string{"bin/myapp", "--port=eighty"}, stm, exiter)
+
+	require.Equal(t, 0, libclimate.MustOptionValue[int](r, "--port"))
+	require.Equal(t, "myapp: --port 'eighty' is not a valid integer; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
@@ -0,0 +1,303 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	angols_slices "github.com/synesissoftware/ANGoLS/slices"
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func usage_lines_(t *testing.T, climate *libclimate.Climate) []string {
+
+	stm := new(bytes.Buffer)
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--help"}, stm, internal.StubExiter{})
+
+	lines, _ := angols_slices.SelectSliceOfString(strings.Split(stm.String(), "\n"), func(_ int, line string) (bool, error) {
+
+		return 0 != len(line), nil
+	})
+
+	return lines
+}
+
+func Test_AliasFlag_Hidden(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+		cl.AddFlag(clasp.Flag("--trace").SetHelp("Traces everything"), libclimate.AliasFlag_Hidden)
+		cl.AddAlias("--trace", "-T")
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	lines := usage_lines_(t, climate)
+
+	require.Contains(t, lines, "\t--debug")
+	require.NotContains(t, lines, "\t--trace")
+	require.NotContains(t, lines, "\t\tTraces everything")
+	require.NotContains(t, lines, "\t-T --trace")
+
+	// still recognised
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r, _ := climate.Parse([]string{"bin/myapp", "--trace"}, stm, exiter)
+
+	require.True(t, r.FlagIsSpecified("--trace"))
+
+	r.Verify()
+
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+}
+
+func Test_AliasFlag_Deprecated(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+
+		cl.AddFlag(clasp.Flag("--colour").SetHelp("Uses colour"), libclimate.AliasFlag_Deprecated)
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	lines := usage_lines_(t, climate)
+
+	require.Contains(t, lines, "\t\tUses colour (deprecated)")
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r, _ := climate.Parse([]string{"bin/myapp", "--colour"}, stm, exiter)
+
+	require.True(t, r.FlagIsSpecified("--colour"))
+	require.Equal(t, "myapp: warning: --colour is deprecated\n", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+	require.Equal(t, 1, climate.NumWarnings())
+}
+
+func Test_AliasFlag_Required(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.UsageHelpSuffix = ""
+
+		cl.AddOption(clasp.Option("--input").SetHelp("Specifies the input"), libclimate.AliasFlag_Required)
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	lines := usage_lines_(t, climate)
+
+	require.Contains(t, lines, "\t\tSpecifies the input (required)")
+
+	{
+		stm := new(bytes.Buffer)
+		exiter := &internal.CaptureExiter{ExitCode: -1}
+
+		_, _ = climate.ParseAndVerify([]string{"bin/myapp"}, stm, exiter, libclimate.ParseFlag_DontCheckUnused)
+
+		require.Equal(t, "myapp: required option --input not specified\n", stm.String())
+		require.Equal(t, 1, exiter.ExitCode)
+	}
+
+	{
+		stm := new(bytes.Buffer)
+		exiter := &internal.CaptureExiter{ExitCode: -1}
+
+		_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--input=file.txt"}, stm, exiter, libclimate.ParseFlag_DontCheckUnused)
+
+		require.Equal(t, "", stm.String())
+		require.Equal(t, -1, exiter.ExitCode)
+	}
+}
+
+func Test_AliasFlag_Required_WITH_DEFAULT(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.UsageHelpSuffix = ""
+
+		cl.AddOption(clasp.Option("--input").SetHelp("Specifies the input").SetDefaultValue("file.txt"), libclimate.AliasFlag_Required)
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r, _ := climate.ParseAndVerify([]string{"bin/myapp"}, stm, exiter, libclimate.ParseFlag_DontCheckUnused)
+
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+
+	input, _ := r.LookupOption("--input")
+
+	require.Equal(t, "file.txt", input.Value)
+}
+
+func Test_AliasFlag_Repeatable(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.UsageHelpSuffix = ""
+
+		cl.AddFlag(clasp.Flag("--verbose").SetHelp("Increases verbosity"), libclimate.AliasFlag_Repeatable)
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	lines := usage_lines_(t, climate)
+
+	require.Contains(t, lines, "\t\tIncreases verbosity (may be specified more than once)")
+	require.Contains(t, lines, "\t\tRuns in debug mode")
+
+	{
+		stm := new(bytes.Buffer)
+		exiter := &internal.CaptureExiter{ExitCode: -1}
+
+		_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--verbose", "--verbose"}, stm, exiter, libclimate.ParseFlag_DontCheckUnused)
+
+		require.Equal(t, "", stm.String())
+		require.Equal(t, -1, exiter.ExitCode)
+	}
+
+	{
+		stm := new(bytes.Buffer)
+		exiter := &internal.CaptureExiter{ExitCode: -1}
+
+		_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--debug", "--debug"}, stm, exiter, libclimate.ParseFlag_DontCheckUnused)
+
+		require.Equal(t, "", stm.String())
+		require.Equal(t, -1, exiter.ExitCode)
+	}
+}
+
+func Test_AliasFlag_Repeatable_NOT_SPECIFIED(t *testing.T) {
+
+	verbosity := 0
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlagFunc(clasp.Flag("--verbose").SetAlias("-v"), func() {
+
+			verbosity++
+		})
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "-v", "-v"}, stm, exiter)
+
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+	require.Equal(t, 2, verbosity)
+}
+
+func Test_AliasFlag_Single(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.UsageHelpSuffix = ""
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"), libclimate.AliasFlag_Single)
+		cl.AddOption(clasp.Option("--level").SetHelp("Specifies the level"), libclimate.AliasFlag_Single)
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	{
+		stm := new(bytes.Buffer)
+		exiter := &internal.CaptureExiter{ExitCode: -1}
+
+		_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--debug", "--level=high"}, stm, exiter, libclimate.ParseFlag_DontCheckUnused)
+
+		require.Equal(t, "", stm.String())
+		require.Equal(t, -1, exiter.ExitCode)
+	}
+
+	{
+		stm := new(bytes.Buffer)
+		exiter := &internal.CaptureExiter{ExitCode: -1}
+
+		_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--debug", "--debug"}, stm, exiter, libclimate.ParseFlag_DontCheckUnused)
+
+		require.Equal(t, "myapp: --debug specified more than once\n", stm.String())
+		require.Equal(t, 1, exiter.ExitCode)
+	}
+
+	{
+		stm := new(bytes.Buffer)
+		exiter := &internal.CaptureExiter{ExitCode: -1}
+
+		_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--level=high", "--level=low"}, stm, exiter, libclimate.ParseFlag_DontCheckUnused)
+
+		require.Equal(t, "myapp: --level specified more than once\n", stm.String())
+		require.Equal(t, 1, exiter.ExitCode)
+	}
+}
+
+func Test_AliasFlag_CallbackAfterVerify(t *testing.T) {
+
+	var calls []string
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.UsageHelpSuffix = ""
+
+		cl.AddFlagFunc(clasp.Flag("--debug").SetHelp("Runs in debug mode"), func() {
+
+			calls = append(calls, "--debug")
+		}, libclimate.AliasFlag_CallbackAfterVerify)
+		cl.AddOptionFunc(clasp.Option("--level").SetHelp("Specifies the level"), func(option *clasp.Argument, _ *clasp.Specification) {
+
+			calls = append(calls, "--level="+option.Value)
+		}, libclimate.AliasFlag_CallbackAfterVerify)
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	{
+		calls = nil
+
+		stm := new(bytes.Buffer)
+		exiter := &internal.CaptureExiter{ExitCode: -1}
+
+		r, _ := climate.Parse([]string{"bin/myapp", "--debug", "--level=3"}, stm, exiter)
+
+		require.Empty(t, calls)
+
+		r.Verify()
+
+		require.Equal(t, []string{"--debug", "--level=3"}, calls)
+		require.Equal(t, "", stm.String())
+		require.Equal(t, -1, exiter.ExitCode)
+	}
+
+	{
+		calls = nil
+
+		stm := new(bytes.Buffer)
+		exiter := &internal.CaptureExiter{ExitCode: -1}
+
+		_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--debug", "--unknown"}, stm, exiter)
+
+		require.Empty(t, calls)
+		require.Equal(t, "myapp: unrecognised flag/option: --unknown\n", stm.String())
+		require.Equal(t, 1, exiter.ExitCode)
+	}
+}
@@ -14,13 +14,31 @@ func Test_INIT_Flags_1(t *testing.T) {
 	require.NotEqual(t, libclimate.InitFlag_None, libclimate.InitFlag_PanicOnFailure)
 	require.NotEqual(t, libclimate.InitFlag_None, libclimate.InitFlag_NoHelpFlag)
 	require.NotEqual(t, libclimate.InitFlag_None, libclimate.InitFlag_NoVersionFlag)
+	require.NotEqual(t, libclimate.InitFlag_None, libclimate.InitFlag_WarningsAreErrors)
+	require.NotEqual(t, libclimate.InitFlag_None, libclimate.InitFlag_VersionFromBuildInfo)
+	require.NotEqual(t, libclimate.InitFlag_None, libclimate.InitFlag_ColorOption)
 
 	require.NotEqual(t, libclimate.InitFlag_PanicOnFailure, libclimate.InitFlag_NoHelpFlag)
 	require.NotEqual(t, libclimate.InitFlag_PanicOnFailure, libclimate.InitFlag_NoVersionFlag)
+	require.NotEqual(t, libclimate.InitFlag_PanicOnFailure, libclimate.InitFlag_WarningsAreErrors)
+	require.NotEqual(t, libclimate.InitFlag_PanicOnFailure, libclimate.InitFlag_VersionFromBuildInfo)
+	require.NotEqual(t, libclimate.InitFlag_PanicOnFailure, libclimate.InitFlag_ColorOption)
 
 	require.NotEqual(t, libclimate.InitFlag_NoHelpFlag, libclimate.InitFlag_NoVersionFlag)
+	require.NotEqual(t, libclimate.InitFlag_NoHelpFlag, libclimate.InitFlag_WarningsAreErrors)
+	require.NotEqual(t, libclimate.InitFlag_NoHelpFlag, libclimate.InitFlag_VersionFromBuildInfo)
+	require.NotEqual(t, libclimate.InitFlag_NoHelpFlag, libclimate.InitFlag_ColorOption)
 
-	require.Equal(t, int64(0), int64(libclimate.InitFlag_PanicOnFailure&libclimate.InitFlag_NoHelpFlag&libclimate.InitFlag_NoVersionFlag))
+	require.NotEqual(t, libclimate.InitFlag_NoVersionFlag, libclimate.InitFlag_WarningsAreErrors)
+	require.NotEqual(t, libclimate.InitFlag_NoVersionFlag, libclimate.InitFlag_VersionFromBuildInfo)
+	require.NotEqual(t, libclimate.InitFlag_NoVersionFlag, libclimate.InitFlag_ColorOption)
+
+	require.NotEqual(t, libclimate.InitFlag_WarningsAreErrors, libclimate.InitFlag_VersionFromBuildInfo)
+	require.NotEqual(t, libclimate.InitFlag_WarningsAreErrors, libclimate.InitFlag_ColorOption)
+
+	require.NotEqual(t, libclimate.InitFlag_VersionFromBuildInfo, libclimate.InitFlag_ColorOption)
+
+	require.Equal(t, int64(0), int64(libclimate.InitFlag_PanicOnFailure&libclimate.InitFlag_NoHelpFlag&libclimate.InitFlag_NoVersionFlag&libclimate.InitFlag_WarningsAreErrors&libclimate.InitFlag_VersionFromBuildInfo&libclimate.InitFlag_ColorOption))
 }
 
 func Test_PARSE_Flags_1(t *testing.T) {
@@ -28,8 +46,44 @@ func Test_PARSE_Flags_1(t *testing.T) {
 
 	require.NotEqual(t, libclimate.ParseFlag_None, libclimate.ParseFlag_PanicOnFailure)
 	require.NotEqual(t, libclimate.ParseFlag_None, libclimate.ParseFlag_DontCheckUnused)
+	require.NotEqual(t, libclimate.ParseFlag_None, libclimate.ParseFlag_CallbackDefaultedOptions)
+	require.NotEqual(t, libclimate.ParseFlag_None, libclimate.ParseFlag_ReportCallbackErrors)
 
 	require.NotEqual(t, libclimate.ParseFlag_PanicOnFailure, libclimate.ParseFlag_DontCheckUnused)
+	require.NotEqual(t, libclimate.ParseFlag_PanicOnFailure, libclimate.ParseFlag_CallbackDefaultedOptions)
+	require.NotEqual(t, libclimate.ParseFlag_PanicOnFailure, libclimate.ParseFlag_ReportCallbackErrors)
+
+	require.NotEqual(t, libclimate.ParseFlag_DontCheckUnused, libclimate.ParseFlag_CallbackDefaultedOptions)
+	require.NotEqual(t, libclimate.ParseFlag_DontCheckUnused, libclimate.ParseFlag_ReportCallbackErrors)
+
+	require.NotEqual(t, libclimate.ParseFlag_CallbackDefaultedOptions, libclimate.ParseFlag_ReportCallbackErrors)
+
+	require.Equal(t, int64(0), int64(libclimate.ParseFlag_PanicOnFailure&libclimate.ParseFlag_DontCheckUnused&libclimate.ParseFlag_CallbackDefaultedOptions&libclimate.ParseFlag_ReportCallbackErrors))
+}
+
+func Test_ALIAS_Flags_1(t *testing.T) {
+	require.Equal(t, int64(0), int64(libclimate.AliasFlag_None))
+
+	flags := []libclimate.AliasFlag{
+		libclimate.AliasFlag_Hidden,
+		libclimate.AliasFlag_Deprecated,
+		libclimate.AliasFlag_Required,
+		libclimate.AliasFlag_Repeatable,
+		libclimate.AliasFlag_CallbackAfterVerify,
+		libclimate.AliasFlag_Negatable,
+		libclimate.AliasFlag_Single,
+	}
+
+	for i, f1 := range flags {
+
+		require.NotEqual(t, libclimate.AliasFlag_None, f1)
+
+		for j, f2 := range flags {
+
+			if i != j {
 
-	require.Equal(t, int64(0), int64(libclimate.ParseFlag_PanicOnFailure&libclimate.ParseFlag_DontCheckUnused))
+				require.Equal(t, int64(0), int64(f1&f2))
+			}
+		}
+	}
 }
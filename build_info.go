@@ -0,0 +1,172 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * constants
+ */
+
+const (
+	modulePath_ = "github.com/synesissoftware/libCLImate.Go"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Obtains the VCS settings - revision, time, and modified - from the given
+// build information.
+func build_info_vcs_(bi *debug.BuildInfo) (revision, time string, modified bool) {
+
+	for _, setting := range bi.Settings {
+
+		switch setting.Key {
+
+		case "vcs.revision":
+
+			revision = setting.Value
+		case "vcs.time":
+
+			time = setting.Value
+		case "vcs.modified":
+
+			modified = "true" == setting.Value
+		}
+	}
+
+	return
+}
+
+// Obtains the version of the main module from the build information, or,
+// if it is not versioned, its (abbreviated) VCS revision, or nil if
+// neither is available.
+func build_info_version_() any {
+
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+
+		return nil
+	}
+
+	version := bi.Main.Version
+
+	if "" == version || "(devel)" == version {
+
+		if revision, _, modified := build_info_vcs_(bi); 0 != len(revision) {
+
+			if 12 < len(revision) {
+
+				revision = revision[:12]
+			}
+
+			if modified {
+
+				revision += "+dirty"
+			}
+
+			return revision
+		}
+	}
+
+	if 0 == len(version) {
+
+		return nil
+	}
+
+	return version
+}
+
+// Finds the first argument of the form "<name>=<form>" - that precedes any
+// "--" - and returns the form, the argument strings without it, and true;
+// or, if there is none, returns argv and false.
+func find_form_argument_(argv []string, name string) (form string, remaining []string, found bool) {
+
+	prefix := name + "="
+
+	for i := 1; i < len(argv); i++ {
+
+		if "--" == argv[i] {
+
+			break
+		}
+
+		if strings.HasPrefix(argv[i], prefix) {
+
+			remaining = append(append([]string(nil), argv[:i]...), argv[i+1:]...)
+
+			return argv[i][len(prefix):], remaining, true
+		}
+	}
+
+	return "", argv, false
+}
+
+// Writes the verbose version, comprising the version, any VCS information,
+// the Go version, and the module dependencies (including this library),
+// and then exits with code 0.
+func show_version_verbose_(params usage_params_) {
+
+	stream := params.Stream
+
+	fmt.Fprintf(stream, "%s %s\n", params.ProgramName, version_string_(params.Version, params.VersionPrefix))
+
+	bi, ok := debug.ReadBuildInfo()
+
+	if ok {
+
+		revision, time, modified := build_info_vcs_(bi)
+
+		if 0 != len(revision) {
+
+			if modified {
+
+				fmt.Fprintf(stream, "revision: %s (modified)\n", revision)
+			} else {
+
+				fmt.Fprintf(stream, "revision: %s\n", revision)
+			}
+		}
+
+		if 0 != len(time) {
+
+			fmt.Fprintf(stream, "commit time: %s\n", time)
+		}
+
+		fmt.Fprintf(stream, "go: %s\n", bi.GoVersion)
+	} else {
+
+		fmt.Fprintf(stream, "go: %s\n", runtime.Version())
+	}
+
+	fmt.Fprintf(stream, "dependencies:\n")
+	fmt.Fprintf(stream, "\t%s %s\n", modulePath_, VersionString())
+
+	if ok {
+
+		for _, dep := range bi.Deps {
+
+			if modulePath_ != dep.Path {
+
+				fmt.Fprintf(stream, "\t%s %s\n", dep.Path, dep.Version)
+			}
+		}
+	}
+
+	params.Exiter.Exit(0)
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
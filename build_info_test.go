@@ -0,0 +1,98 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func Test_VersionVerbose(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.Version = "0.0.1"
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--version=verbose"}, stm, exiter)
+
+	lines := strings.Split(stm.String(), "\n")
+
+	require.Equal(t, "myapp 0.0.1", lines[0])
+	require.Contains(t, lines, "go: "+runtime.Version())
+	require.Contains(t, lines, "dependencies:")
+	require.Contains(t, lines, "\tgithub.com/synesissoftware/libCLImate.Go "+libclimate.VersionString())
+	require.Equal(t, 0, exiter.ExitCode)
+}
+
+func Test_VersionForm_UNRECOGNISED(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+		cl.Version = "0.0.1"
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.Parse([]string{"bin/myapp", "--version=xml"}, stm, exiter)
+
+	require.Equal(t, "myapp: unrecognised --version form 'xml'; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_VersionForm_AFTER_DOUBLE_HYPHEN(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.Version = "0.0.1"
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r, _ := climate.ParseAndVerify([]string{"bin/myapp", "--", "--version=verbose"}, stm, exiter)
+
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+	require.Equal(t, 1, len(r.Values))
+}
+
+func Test_VersionFromBuildInfo(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.Version = "1.2.3"
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure, libclimate.InitFlag_VersionFromBuildInfo)
+
+	require.Equal(t, "1.2.3", climate.Version)
+
+	climate, _ = libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure, libclimate.InitFlag_VersionFromBuildInfo)
+
+	// A test binary has (at most) a development version
+
+	if climate.Version != nil {
+
+		require.NotEmpty(t, climate.Version)
+	}
+}
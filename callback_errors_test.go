@@ -0,0 +1,218 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func callback_errors_climate_(port *int) *libclimate.Climate {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+
+		cl.AddFlagErrorFunc(clasp.Flag("--fail").SetHelp("Fails"), func() error {
+
+			return errors.New("--fail specified")
+		})
+
+		cl.AddOptionErrorFunc(clasp.Option("--port").SetHelp("Specifies the port"), func(option *clasp.Argument, _ *clasp.Specification) error {
+
+			v, err := strconv.Atoi(option.Value)
+			if err != nil {
+
+				return fmt.Errorf("invalid port '%s'", option.Value)
+			}
+
+			*port = v
+
+			return nil
+		})
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	return climate
+}
+
+func Test_CallbackErrors_NONE(t *testing.T) {
+
+	var port int
+
+	climate := callback_errors_climate_(&port)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r, err := climate.Parse([]string{"bin/myapp", "--port=8080"}, stm, exiter)
+
+	require.Nil(t, err)
+	require.Equal(t, 8080, port)
+	require.Equal(t, 1, len(r.Options))
+}
+
+func Test_CallbackErrors_RETURNED(t *testing.T) {
+
+	var port int
+
+	climate := callback_errors_climate_(&port)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r, err := climate.Parse([]string{"bin/myapp", "--port=abc", "--fail"}, stm, exiter)
+
+	require.NotNil(t, err)
+	require.Equal(t, "invalid port 'abc'\n--fail specified", err.Error())
+	require.Equal(t, 1, len(r.Flags))
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+}
+
+func Test_CallbackErrors_REPORTED(t *testing.T) {
+
+	var port int
+
+	climate := callback_errors_climate_(&port)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, err := climate.Parse([]string{"bin/myapp", "--port=abc"}, stm, exiter, libclimate.ParseFlag_ReportCallbackErrors)
+
+	require.Nil(t, err)
+	require.Equal(t, "myapp: invalid port 'abc'; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_CallbackErrors_PANIC(t *testing.T) {
+
+	var port int
+
+	climate := callback_errors_climate_(&port)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	require.Panics(t, func() {
+
+		_, _ = climate.Parse([]string{"bin/myapp", "--fail"}, stm, exiter, libclimate.ParseFlag_PanicOnFailure)
+	})
+}
+
+func Test_CallbackErrors_AFTER_VERIFY(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlagErrorFunc(clasp.Flag("--fail").SetHelp("Fails"), func() error {
+
+			return errors.New("cannot fail now")
+		}, libclimate.AliasFlag_CallbackAfterVerify)
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, err := climate.ParseAndVerify([]string{"bin/myapp", "--fail"}, stm, exiter)
+
+	require.Nil(t, err)
+	require.Equal(t, "myapp: cannot fail now; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_CallbackType_NIL_FLAG_FUNC(t *testing.T) {
+
+	climate, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlagFunc(clasp.Flag("--debug").SetHelp("Runs in debug mode"), nil)
+		cl.AddFlagErrorFunc(clasp.Flag("--fail").SetHelp("Fails"), nil)
+
+		return nil
+	})
+
+	require.NotNil(t, climate)
+	require.NotNil(t, err)
+	require.Equal(t, "nil flag function for '--debug'\nnil flag function for '--fail'", err.Error())
+}
+
+func Test_CallbackType_NIL_OPTION_FUNC(t *testing.T) {
+
+	_, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddOptionFunc(clasp.Option("--level").SetHelp("Specifies the level"), nil)
+		cl.AddOptionErrorFunc(clasp.Option("--port").SetHelp("Specifies the port"), nil)
+
+		return nil
+	})
+
+	require.NotNil(t, err)
+	require.Equal(t, "nil option function for '--level'\nnil option function for '--port'", err.Error())
+
+	require.Panics(t, func() {
+
+		_, _ = libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+			cl.AddOptionFunc(clasp.Option("--level").SetHelp("Specifies the level"), nil)
+
+			return nil
+		}, libclimate.InitFlag_PanicOnFailure)
+	})
+}
+
+func Test_CallbackType_NIL_AFTER_INIT(t *testing.T) {
+
+	climate, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		return nil
+	})
+
+	require.Nil(t, err)
+
+	require.PanicsWithError(t, "nil flag function for '--debug'", func() {
+
+		climate.AddFlagFunc(clasp.Flag("--debug").SetHelp("Runs in debug mode"), nil)
+	})
+
+	require.PanicsWithError(t, "nil option function for '--port'", func() {
+
+		climate.AddOptionErrorFunc(clasp.Option("--port").SetHelp("Specifies the port"), nil)
+	})
+}
+
+func Test_CallbackType_NAMED_AND_UNNAMED(t *testing.T) {
+
+	var called []string
+
+	climate, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlagFunc(clasp.Flag("--a").SetHelp("A"), libclimate.FlagFunc(func() { called = append(called, "a") }))
+		cl.AddFlagFunc(clasp.Flag("--b").SetHelp("B"), func() { called = append(called, "b") })
+		cl.AddOptionFunc(clasp.Option("--c").SetHelp("C"), libclimate.OptionFunc(func(option *clasp.Argument, _ *clasp.Specification) { called = append(called, option.Value) }))
+		cl.AddOptionFunc(clasp.Option("--d").SetHelp("D"), func(option *clasp.Argument, _ *clasp.Specification) { called = append(called, option.Value) })
+
+		return nil
+	})
+
+	require.Nil(t, err)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, err = climate.ParseAndVerify([]string{"bin/myapp", "--a", "--b", "--c=c", "--d=d"}, stm, exiter)
+
+	require.Nil(t, err)
+	require.Equal(t, []string{"a", "b", "c", "d"}, called)
+	require.Equal(t, "", stm.String())
+}
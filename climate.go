@@ -4,7 +4,7 @@
 
 /*
  * Created: 22nd March 2019
- * Updated: 20th August 2026
+ * Updated: 19th October 2026
  */
 
 package libclimate
@@ -14,10 +14,12 @@ import (
 
 	clasp "github.com/synesissoftware/CLASP.Go"
 
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path"
+	"strings"
 	"unicode"
 )
 
@@ -30,22 +32,59 @@ type ParseFlag int64
 // Type of flags passed to the [Climate.AddFlag] and [Climate.AddOption] methods.
 type AliasFlag int64
 
+// Option type that may be passed to [Init], [Climate.Parse], and
+// [Climate.ParseAndVerify] to specify the stream to which help and version
+// output is written. Defaults to [os.Stdout].
+type OutputStream struct {
+	Writer io.Writer
+}
+
+// Option type that may be passed to [Init], [Climate.Parse],
+// [Climate.ParseAndVerify], [Climate.Abort], [Climate.Warn], and
+// [Result.Verify] to specify the stream to which diagnostics are written.
+// Defaults to [os.Stderr].
+type ErrorStream struct {
+	Writer io.Writer
+}
+
 // Structure representing a CLI parsing context, obtained from [Init].
 type Climate struct {
-	Specifications   []*clasp.Specification // The specifications created by [Init].
-	ParseFlags       clasp.ParseFlag        // Parsing flags.
-	Version          any                    // Version field that can be specified by application code in the function called by [Init].
-	VersionPrefix    string                 // Version-prefix field that can be specified by application code in the function called by [Init].
-	InfoLines        []string               // Information lines field that can be specified by application code in the function called by [Init].
-	ValuesString     string                 // Values-string field that can be specified by application code in the function called by [Init].
-	ProgramName      string                 // Program-name field that can be specified by application code in the function called by [Init]. Defaults to `os.Args[0]`.
-	ValueNames       []string               // Specifies a list of value names that may be used in a contingent report when insufficient values are specified on the command-line (as determined by [Climate.ValuesConstraint]).
-	ValuesConstraint []int                  // An array of 1 or 2 numbers that specify the number of values, or the minimum and maximum number of values, required. A value of -1 means "no constraint", so, for example, the constraint `{2, -1}` means 2+ values are required.
-	UsageHelpSuffix  string                 // An optional string to be applied to the end of the contingent report produced by [Climate.Abort]. Defaults to nothing. Specify ":" for default suffix string of "; use --help for usage". Insert leading "; " unless first character is punctuation.
-
-	initFlags InitFlag
-	stream    io.Writer
-	exiter    internal.Exiter
+	Specifications      []*clasp.Specification // The specifications created by [Init].
+	ParseFlags          clasp.ParseFlag        // Parsing flags.
+	Version             any                    // Version field that can be specified by application code in the function called by [Init].
+	VersionPrefix       string                 // Version-prefix field that can be specified by application code in the function called by [Init].
+	InfoLines           []string               // Information lines field that can be specified by application code in the function called by [Init]. May contain placeholders (see [Climate.AddPlaceholder]).
+	ValuesString        string                 // Values-string field that can be specified by application code in the function called by [Init].
+	ProgramName         string                 // Program-name field that can be specified by application code in the function called by [Init]. Defaults to `os.Args[0]`.
+	ValueNames          []string               // Specifies a list of value names that may be used in a contingent report when insufficient values are specified on the command-line (as determined by [Climate.ValuesConstraint]).
+	ValuesConstraint    []int                  // An array of 1 or 2 numbers that specify the number of values, or the minimum and maximum number of values, required. A value of -1 means "no constraint", so, for example, the constraint `{2, -1}` means 2+ values are required.
+	UsageHelpSuffix     string                 // An optional string to be applied to the end of the contingent report produced by [Climate.Abort]. Defaults to nothing. Specify ":" for default suffix string of "; use --help for usage". Insert leading "; " unless first character is punctuation. May contain placeholders (see [Climate.AddPlaceholder]).
+	ValueSpecifications []*ValueSpecification  // The value specifications, added via [Climate.AddValue].
+	Author              string                 // Author field, available as the placeholder "{{.Author}}".
+	Homepage            string                 // Homepage field, available as the placeholder "{{.Homepage}}".
+	CopyrightStartYear  int                    // Copyright start-year field, from which the copyright year range (to the current year) is available as the placeholder "{{.Copyright}}".
+	Examples            []UsageExample         // Example invocations, shown in the "examples" section of the usage (see [Climate.AddExample]). May contain placeholders (see [Climate.AddPlaceholder]).
+	Environment         []EnvironmentVariable  // Environment variables, shown in the "environment" section of the usage (see [Climate.AddEnvironmentVariable]).
+	ExitStatuses        []ExitStatus           // Exit statuses, shown in the "exit status" section of the usage (see [Climate.AddExitStatus]).
+	SeeAlso             []string               // References, e.g. "otherapp(1)", shown in the "see also" section of the usage.
+	Epilog              []string               // Lines shown at the end of the usage. May contain placeholders (see [Climate.AddPlaceholder]).
+	HelpTopics          []HelpTopic            // Named help topics, shown by "--help=<name>", and listed in the "help topics" section of the usage (see [Climate.AddHelpTopic]). May contain placeholders (see [Climate.AddPlaceholder]).
+	Color               ColorMode              // Specifies whether the usage and the diagnostics are coloured. Defaults to ColorMode_Auto, which honours the NO_COLOR and CLICOLOR_FORCE environment variables. May be overridden by the "--color" option (see InitFlag_ColorOption).
+	UsageRenderer       UsageRenderer          // The renderer of the usage and the version. Defaults to nil, meaning [TextUsageRenderer].
+	UsageWidth          int                    // The width to which the help descriptions in the usage are wrapped. Defaults to 0, meaning, if the output stream is a terminal, that specified by the COLUMNS environment variable, or else that of the terminal, or else (if the output stream is not a terminal) UsageWidth_Default. Specify UsageWidth_NoWrap for no wrapping.
+
+	initFlags   InitFlag
+	outStream   io.Writer
+	errStream   io.Writer
+	exiter      internal.Exiter
+	numWarnings *int
+	initErrors  []error
+	inInit      bool
+
+	resultValidators []ResultValidatorFunc
+	placeholders     map[string]any
+	section          string
+	colorOption      *string
 }
 
 // Structure representing CLI results, obtained from [Climate.Parse].
@@ -58,13 +97,24 @@ type Result struct {
 
 	arguments        *clasp.Arguments
 	parseFlags       ParseFlag
-	stream           io.Writer
+	errStream        io.Writer
 	exiter           internal.Exiter
+	specifications   []*clasp.Specification
 	valueNames       []string
 	valuesConstraint []int
 	usageHelpSuffix  string
+	deferred         []func() error
+	defaults         []*clasp.Argument
+	bindErrors       []error
+	valueSpecs       []*ValueSpecification
+	resultValidators []ResultValidatorFunc
+	colorMode        ColorMode
 }
 
+// Tri-state outcome of a flag qualified by AliasFlag_Negatable, obtained
+// from [Result.LookupFlagState].
+type FlagState int
+
 // Callback function for specification of Climate via DSL.
 type InitFunc func(cl *Climate) error
 
@@ -76,14 +126,46 @@ type FlagFunc func()
 // specification.
 type OptionFunc func(option *clasp.Argument, specification *clasp.Specification)
 
+// Type of callback function that may be specified to
+// [Climate.AddFlagErrorFunc], which may fail.
+type FlagErrorFunc func() error
+
+// Type of callback function that may be specified to
+// [Climate.AddOptionErrorFunc], which receives the argument and its
+// specification, and which may fail.
+type OptionErrorFunc func(option *clasp.Argument, specification *clasp.Specification) error
+
 const (
 	InitFlag_None InitFlag = 0 // No initialisation flags specified.
 )
 
 const (
-	InitFlag_PanicOnFailure InitFlag = 1 << iota // Causes [Init] to panic if an error encountered during processing.
-	InitFlag_NoHelpFlag                          // Suppresses the provision and processing of a help flag (aka "--help").
-	InitFlag_NoVersionFlag                       // Suppresses the provision and processing of a version flag (aka "--version").
+	InitFlag_PanicOnFailure       InitFlag = 1 << iota // Causes [Init] to panic if an error encountered during processing.
+	InitFlag_NoHelpFlag                                // Suppresses the provision and processing of a help flag (aka "--help").
+	InitFlag_NoVersionFlag                             // Suppresses the provision and processing of a version flag (aka "--version").
+	InitFlag_WarningsAreErrors                         // Causes warnings issued via [Climate.Warn] and [Climate.Warnf] to be treated as errors, i.e. reported as by [Climate.Abort].
+	InitFlag_VersionFromBuildInfo                      // Causes [Climate.Version], if not specified in the function called by [Init], to be obtained from the build information (see [debug.ReadBuildInfo]).
+	InitFlag_ColorOption                               // Causes the provision and processing of a colour option (aka "--color"), taking the value "auto", "always", or "never", that overrides [Climate.Color].
+)
+
+const (
+	AliasFlag_None AliasFlag = 0 // No alias flags specified.
+)
+
+const (
+	AliasFlag_Hidden              AliasFlag = 1 << iota // Causes the flag/option to be omitted from the usage.
+	AliasFlag_Deprecated                                // Causes a warning to be issued when the flag/option is used, and marks it as "(deprecated)" in the usage.
+	AliasFlag_Required                                  // Causes [Result.Verify] to report if the flag/option is not specified, and marks it as "(required)" in the usage.
+	AliasFlag_Repeatable                                // Marks the flag/option in the usage as one that may be specified more than once.
+	AliasFlag_CallbackAfterVerify                       // Causes the flag/option function to be invoked by [Result.Verify], after all verification has succeeded, rather than by [Climate.Parse].
+	AliasFlag_Negatable                                 // Causes a negating flag, named "--no-<name>", to be provided for a flag, the outcome of which may be obtained via [Result.LookupFlagState]. The negation is hidden and/or deprecated as is the flag, but does not invoke any flag function, so the outcome should be obtained only via [Result.LookupFlagState] or [FlagVar].
+	AliasFlag_Single                                    // Causes [Result.Verify] to report if the flag/option is specified more than once.
+)
+
+const (
+	FlagState_Unspecified FlagState = iota // Neither the flag nor its negation was specified.
+	FlagState_Set                          // The flag was the last of the flag and its negation to be specified.
+	FlagState_Unset                        // The negation was the last of the flag and its negation to be specified.
 )
 
 const (
@@ -91,13 +173,20 @@ const (
 )
 
 const (
-	ParseFlag_PanicOnFailure  ParseFlag = 1 << iota // Causes [Climate.Parse] to panic if an error encountered during processing.
-	ParseFlag_DontCheckUnused                       // Causes [Climate.Verify] to ignore unrecognised arguments.
+	ParseFlag_PanicOnFailure           ParseFlag = 1 << iota // Causes [Climate.Parse] to panic if an error encountered during processing.
+	ParseFlag_DontCheckUnused                                // Causes [Climate.Verify] to ignore unrecognised arguments.
+	ParseFlag_CallbackDefaultedOptions                       // Causes [Climate.Parse] to invoke the option function of an option that is not specified but has a default value.
+	ParseFlag_ReportCallbackErrors                           // Causes [Climate.Parse] to report the first error returned by a flag/option function in the same manner as by [Climate.Abort], rather than to return the errors.
 )
 
 const (
 	_libCLImate_FlagFunc   = "_libCLImate_FlagFunc_F73BB1C0_92D7_4cd5_9C36_DB672290CBE7"
 	_libCLImate_OptionFunc = "_libCLImate_OptionFunc_F73BB1C0_92D7_4cd5_9C36_DB672290CBE7"
+	_libCLImate_AliasFlags = "_libCLImate_AliasFlags_F73BB1C0_92D7_4cd5_9C36_DB672290CBE7"
+	_libCLImate_Alias      = "_libCLImate_Alias_F73BB1C0_92D7_4cd5_9C36_DB672290CBE7"
+	_libCLImate_MacroAlias = "_libCLImate_MacroAlias_F73BB1C0_92D7_4cd5_9C36_DB672290CBE7"
+	_libCLImate_NegationOf = "_libCLImate_NegationOf_F73BB1C0_92D7_4cd5_9C36_DB672290CBE7"
+	_libCLImate_Binder     = "_libCLImate_Binder_F73BB1C0_92D7_4cd5_9C36_DB672290CBE7"
 )
 
 const (
@@ -138,6 +227,69 @@ func parse_Stream_from_options_(options ...any) (result io.Writer, err error) {
 	return
 }
 
+func parse_OutputStream_from_options_(options ...any) (result io.Writer, err error) {
+
+	for _, option := range options {
+
+		switch v := option.(type) {
+
+		case OutputStream:
+
+			return v.Writer, nil
+		}
+	}
+
+	return
+}
+
+func parse_ErrorStream_from_options_(options ...any) (result io.Writer, err error) {
+
+	for _, option := range options {
+
+		switch v := option.(type) {
+
+		case ErrorStream:
+
+			return v.Writer, nil
+		}
+	}
+
+	return
+}
+
+// Obtains the output and error streams from the given options, where a
+// typed [OutputStream] or [ErrorStream] takes precedence over an untyped
+// [io.Writer], which applies to both.
+func parse_Streams_from_options_(options ...any) (outStream, errStream io.Writer, err error) {
+
+	var stream io.Writer
+
+	if err == nil {
+
+		stream, err = parse_Stream_from_options_(options...)
+	}
+
+	if err == nil {
+
+		outStream, err = parse_OutputStream_from_options_(options...)
+	}
+	if err == nil && outStream == nil {
+
+		outStream = stream
+	}
+
+	if err == nil {
+
+		errStream, err = parse_ErrorStream_from_options_(options...)
+	}
+	if err == nil && errStream == nil {
+
+		errStream = stream
+	}
+
+	return
+}
+
 func parse_InitFlags_from_options_(options ...any) (result InitFlag, err error) {
 
 	for _, option := range options {
@@ -168,18 +320,182 @@ func parse_ParseFlags_from_options_(options ...any) (result ParseFlag, err error
 	return
 }
 
-func pointer_specifications_to_value_specifications(input []*clasp.Specification) (result []clasp.Specification) {
+// Obtains the specifications to be passed to [clasp.Parse], which excludes
+// macro aliases (since they are expanded by expand_macro_aliases_()).
+func parse_specifications_(input []*clasp.Specification) (result []clasp.Specification) {
+
+	result = make([]clasp.Specification, 0, len(input))
 
-	result = make([]clasp.Specification, len(input))
+	for _, spec := range input {
 
-	for i, a := range input {
+		if _, is_macro := spec.Extras[_libCLImate_MacroAlias]; !is_macro {
 
-		result[i] = *a
+			result = append(result, *spec)
+		}
 	}
 
 	return
 }
 
+// Expands any macro aliases in argv (up to the first "--"), except where
+// the argument is the value of a preceding option, i.e. one specified (by
+// name or alias) without "=", as in "--name value".
+func expand_macro_aliases_(argv []string, specs []*clasp.Specification) []string {
+
+	macros := make(map[string][]string)
+	options := make(map[string]bool)
+
+	for _, spec := range specs {
+
+		if expansion, is_macro := spec.Extras[_libCLImate_MacroAlias].([]string); is_macro {
+
+			for _, alias := range spec.Aliases {
+
+				macros[alias] = expansion
+			}
+		} else if clasp.OptionType == spec.Type {
+
+			options[spec.Name] = true
+
+			for _, alias := range spec.Aliases {
+
+				options[alias] = true
+			}
+		}
+	}
+
+	if 0 == len(macros) || 0 == len(argv) {
+
+		return argv
+	}
+
+	// an alias that resolves to an option (rather than to an
+	// option-with-value) also takes the next argument as its value
+
+	for _, spec := range specs {
+
+		if _, is_macro := spec.Extras[_libCLImate_MacroAlias]; !is_macro && is_alias_specification_(spec) && options[spec.Name] {
+
+			for _, alias := range spec.Aliases {
+
+				options[alias] = true
+			}
+		}
+	}
+
+	result := make([]string, 1, len(argv))
+	result[0] = argv[0]
+
+	is_value := false
+
+	for i := 1; i != len(argv); i++ {
+
+		arg := argv[i]
+
+		if is_value {
+
+			result = append(result, arg)
+
+			is_value = false
+
+			continue
+		}
+
+		if "--" == arg {
+
+			result = append(result, argv[i:]...)
+
+			break
+		}
+
+		if expansion, ok := macros[arg]; ok {
+
+			result = append(result, expansion...)
+		} else {
+
+			result = append(result, arg)
+
+			is_value = options[arg]
+		}
+	}
+
+	return result
+}
+
+func alias_flags_of_(spec *clasp.Specification) AliasFlag {
+
+	if spec != nil {
+
+		if af, ok := spec.Extras[_libCLImate_AliasFlags].(AliasFlag); ok {
+
+			return af
+		}
+	}
+
+	return AliasFlag_None
+}
+
+// Obtains the name of the negation of the named flag, e.g. "--no-debug"
+// for "--debug".
+func negation_name_(name string) string {
+
+	return "--no-" + strings.TrimLeft(name, "-")
+}
+
+// Obtains the name of the flag negated by the given specification, if it
+// is a negation.
+func negation_of_(spec *clasp.Specification) (string, bool) {
+
+	if spec != nil {
+
+		if name, ok := spec.Extras[_libCLImate_NegationOf].(string); ok {
+
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// Indicates whether an option of the given specification is specified in
+// the arguments.
+func option_is_specified_(arguments *clasp.Arguments, spec *clasp.Specification) bool {
+
+	for _, argument := range arguments.Options {
+
+		if argument.ArgumentSpecification != nil && argument.ArgumentSpecification.Name == spec.Name {
+
+			return true
+		}
+
+		if argument.ResolvedName == spec.Name {
+
+			return true
+		}
+	}
+
+	return false
+}
+
+func name_of_id_(id any) string {
+
+	switch v := id.(type) {
+
+	case string:
+
+		return v
+	case clasp.Specification:
+
+		return v.Name
+	case *clasp.Specification:
+
+		return v.Name
+	default:
+
+		return fmt.Sprint(id)
+	}
+}
+
 func uhs_(uhs string) string {
 
 	switch uhs {
@@ -212,10 +528,23 @@ func uhs_(uhs string) string {
 
 // Initialises a Climate instance, according to the given function (which
 // may not be nil) and arguments.
+//
+// In addition to any error returned by the function, an error is returned
+// if any specification added by it is invalid, including: a nil
+// flag/option function (see [Climate.AddFlagFunc] and
+// [Climate.AddOptionFunc]); a duplicate flag/option name; an alias that
+// collides with another, or with a name (including those of the "--help"
+// and "--version" flags), or that contains '='; an alias that resolves to
+// an unknown flag/option; an impossible values-constraint (see
+// [Climate.ValuesConstraint]); a help topic whose name is ambiguous (see
+// [Climate.AddHelpTopic]); and an invalid placeholder template, or one
+// that refers to an unknown placeholder, in the info lines, usage-help
+// suffix, epilog, examples, or help topics (see [Climate.AddPlaceholder]).
 func Init(initFn InitFunc, options ...any) (climate *Climate, err error) {
 
 	var initFlags InitFlag
-	var stream io.Writer
+	var outStream io.Writer
+	var errStream io.Writer
 	var exiter internal.Exiter
 
 	if err == nil {
@@ -225,7 +554,7 @@ func Init(initFn InitFunc, options ...any) (climate *Climate, err error) {
 
 	if err == nil {
 
-		stream, err = parse_Stream_from_options_(options...)
+		outStream, errStream, err = parse_Streams_from_options_(options...)
 	}
 
 	if err == nil {
@@ -257,9 +586,12 @@ func Init(initFn InitFunc, options ...any) (climate *Climate, err error) {
 			// ValuesConstraint:
 			UsageHelpSuffix: UsageHelpSuffix_Default,
 
-			initFlags: initFlags,
-			stream:    stream,
-			exiter:    exiter,
+			initFlags:   initFlags,
+			outStream:   outStream,
+			errStream:   errStream,
+			exiter:      exiter,
+			numWarnings: new(int),
+			colorOption: new(string),
 		}
 
 		if 0 == (initFlags & InitFlag_NoHelpFlag) {
@@ -272,7 +604,33 @@ func Init(initFn InitFunc, options ...any) (climate *Climate, err error) {
 			climate.AddFlag(clasp.VersionFlag())
 		}
 
+		if 0 != (initFlags & InitFlag_ColorOption) {
+
+			climate.AddOption(color_option_())
+		}
+
+		climate.inInit = true
+
 		err = initFn(climate)
+
+		climate.inInit = false
+
+		if err == nil && 0 != (initFlags&InitFlag_VersionFromBuildInfo) && climate.Version == nil {
+
+			climate.Version = build_info_version_()
+		}
+
+		if err == nil {
+
+			errs := climate.initErrors
+
+			errs = append(errs, lint_specifications_(climate.Specifications)...)
+			errs = append(errs, lint_values_(climate.ValuesConstraint, climate.ValueSpecifications)...)
+			errs = append(errs, lint_help_topics_(climate.HelpTopics, climate.Specifications)...)
+			errs = append(errs, climate.lint_placeholders_()...)
+
+			err = errors.Join(errs...)
+		}
 	}
 
 	if err != nil {
@@ -293,173 +651,575 @@ func Init(initFn InitFunc, options ...any) (climate *Climate, err error) {
 // contain an equals sign.
 func (cl *Climate) AddAlias(resolved_name, alias string) {
 
-	f := clasp.Flag(resolved_name).SetAlias(alias)
+	f := clasp.Flag(resolved_name).SetAlias(alias).SetExtra(_libCLImate_Alias, true)
+
+	cl.append_specification_(&f)
+}
+
+// Adds a macro alias to the Climate instance
+//
+// The alias param is the alias (which must not contain an equals sign),
+// and the resolved_names are the names of flags or options, or
+// options-with-values, into which the alias is expanded, as in:
+//
+//	cl.AddMacroAlias("-C", "--verbosity=chatty", "--debug", "--color=always")
+//
+// Each expanded argument is processed as if specified individually on the
+// command-line, including the invocation of any flag/option function.
+func (cl *Climate) AddMacroAlias(alias string, resolved_names ...string) {
+
+	expansion := append([]string(nil), resolved_names...)
+
+	f := clasp.Flag(strings.Join(expansion, " ")).SetAlias(alias).SetExtra(_libCLImate_Alias, true).SetExtra(_libCLImate_MacroAlias, expansion)
 
-	cl.Specifications = append(cl.Specifications, &f)
+	cl.append_specification_(&f)
 }
 
-// Adds a (copy of the) flag to the Climate instance.
+// Adds a (copy of the) flag to the Climate instance, qualified by any
+// given alias flags.
 func (cl *Climate) AddFlag(flag clasp.Specification, flags ...AliasFlag) {
 
-	cl.Specifications = append(cl.Specifications, &flag)
+	cl.add_(flag, flags...)
 }
 
-// Adds a (copy of the) flag to the Climate instance.
+// Adds a (copy of the) flag to the Climate instance, qualified by any
+// given alias flags, along with a flag function that is invoked when the
+// flag is specified.
+//
+// If the flag is qualified by AliasFlag_Negatable, the function is not
+// invoked when its negation is specified, so the outcome should instead be
+// obtained via [Result.LookupFlagState] or [FlagVar].
 func (cl *Climate) AddFlagFunc(flag clasp.Specification, flagFn FlagFunc, flags ...AliasFlag) {
 
-	newFlag := flag.SetExtra(_libCLImate_FlagFunc, flagFn)
+	if flagFn == nil {
+
+		cl.init_error_(fmt.Errorf("nil flag function for '%s'", flag.Name))
+	} else {
+
+		flag = flag.SetExtra(_libCLImate_FlagFunc, flagFn)
+	}
+
+	cl.add_(flag, flags...)
+}
+
+// Adds a (copy of the) flag to the Climate instance, qualified by any
+// given alias flags, along with a flag function that is invoked when the
+// flag is specified and that may fail.
+//
+// If the flag is qualified by AliasFlag_Negatable, the function is not
+// invoked when its negation is specified, so the outcome should instead be
+// obtained via [Result.LookupFlagState] or [FlagVar].
+//
+// Any error returned by the function is returned by [Climate.Parse], or
+// reported by it if ParseFlag_ReportCallbackErrors is specified.
+func (cl *Climate) AddFlagErrorFunc(flag clasp.Specification, flagFn FlagErrorFunc, flags ...AliasFlag) {
+
+	if flagFn == nil {
+
+		cl.init_error_(fmt.Errorf("nil flag function for '%s'", flag.Name))
+	} else {
+
+		flag = flag.SetExtra(_libCLImate_FlagFunc, flagFn)
+	}
 
-	cl.Specifications = append(cl.Specifications, &newFlag)
+	cl.add_(flag, flags...)
 }
 
-// Adds a (copy of the) option to the Climate instance.
+// Adds a (copy of the) option to the Climate instance, qualified by any
+// given alias flags.
 func (cl *Climate) AddOption(option clasp.Specification, flags ...AliasFlag) {
 
-	cl.Specifications = append(cl.Specifications, &option)
+	cl.add_(option, flags...)
 }
 
-// Adds a (copy of the) option to the Climate instance.
+// Adds a (copy of the) option to the Climate instance, qualified by any
+// given alias flags, along with an option function that is invoked when
+// the option is specified.
 func (cl *Climate) AddOptionFunc(option clasp.Specification, optionFn OptionFunc, flags ...AliasFlag) {
 
-	newOption := option.SetExtra(_libCLImate_OptionFunc, optionFn)
+	if optionFn == nil {
+
+		cl.init_error_(fmt.Errorf("nil option function for '%s'", option.Name))
+	} else {
+
+		option = option.SetExtra(_libCLImate_OptionFunc, optionFn)
+	}
 
-	cl.Specifications = append(cl.Specifications, &newOption)
+	cl.add_(option, flags...)
 }
 
-// Parses a command line, obtaining a Result instance representing the
-// arguments received by the process.
-func (cl Climate) Parse(argv []string, options ...any) (result Result, err error) {
+// Adds a (copy of the) option to the Climate instance, qualified by any
+// given alias flags, along with an option function that is invoked when
+// the option is specified and that may fail.
+//
+// Any error returned by the function is returned by [Climate.Parse], or
+// reported by it if ParseFlag_ReportCallbackErrors is specified.
+func (cl *Climate) AddOptionErrorFunc(option clasp.Specification, optionFn OptionErrorFunc, flags ...AliasFlag) {
 
-	var parseFlags ParseFlag
-	var stream io.Writer
-	var exiter internal.Exiter
-	var arguments *clasp.Arguments
+	if optionFn == nil {
 
-	if err == nil {
+		cl.init_error_(fmt.Errorf("nil option function for '%s'", option.Name))
+	} else {
 
-		parseFlags, err = parse_ParseFlags_from_options_(options...)
+		option = option.SetExtra(_libCLImate_OptionFunc, optionFn)
 	}
 
-	if err == nil {
+	cl.add_(option, flags...)
+}
 
-		stream, err = parse_Stream_from_options_(options...)
-	}
-	if err == nil && stream == nil {
+// Records the given error, to be returned by [Init], if within the
+// function called by it, or otherwise panics, since there is then no means
+// to report it.
+func (cl *Climate) init_error_(err error) {
 
-		if cl.stream != nil {
+	if cl.inInit {
 
-			stream = cl.stream
-		} else {
+		cl.initErrors = append(cl.initErrors, err)
+	} else {
 
-			stream = os.Stderr
-		}
+		panic(err)
 	}
+}
 
-	if err == nil {
+func (cl *Climate) add_(spec clasp.Specification, flags ...AliasFlag) {
 
-		exiter, err = parse_Exiter_from_options_(options...)
-	}
-	if err == nil && exiter == nil {
+	var aliasFlags AliasFlag
 
-		exiter = cl.exiter
+	for _, flag := range flags {
+
+		aliasFlags |= flag
 	}
 
-	if err == nil {
+	if AliasFlag_None != aliasFlags {
 
-		parse_params := clasp.ParseParams{
+		spec = spec.SetExtra(_libCLImate_AliasFlags, aliasFlags)
+	}
 
-			Specifications: pointer_specifications_to_value_specifications(cl.Specifications),
-		}
+	cl.append_specification_(&spec)
 
-		arguments = clasp.Parse(argv, parse_params)
+	if 0 != (AliasFlag_Negatable&aliasFlags) && clasp.FlagType == spec.Type {
 
-		if 0 == (cl.initFlags & InitFlag_NoHelpFlag) {
+		negation := clasp.Flag(negation_name_(spec.Name)).
+			SetHelp(fmt.Sprintf("Negates %s", spec.Name)).
+			SetExtra(_libCLImate_NegationOf, spec.Name)
 
-			if arguments.FlagIsSpecified(clasp.HelpFlag()) {
+		// The negation is hidden/deprecated as is the flag, but does not
+		// invoke its flag function, which could not tell them apart
 
-				clasp.ShowUsage(parse_params.Specifications, clasp.UsageParams{
+		if negationFlags := aliasFlags & (AliasFlag_Hidden | AliasFlag_Deprecated); AliasFlag_None != negationFlags {
 
-					Version:       cl.Version,
-					VersionPrefix: cl.VersionPrefix,
-					InfoLines:     cl.InfoLines,
-					ValuesString:  cl.ValuesString,
-					Stream:        stream,
-					Exiter:        exiter,
-					ProgramName:   arguments.ProgramName,
-				})
-			}
+			negation = negation.SetExtra(_libCLImate_AliasFlags, negationFlags)
 		}
 
-		if 0 == (cl.initFlags & InitFlag_NoVersionFlag) {
-
-			if arguments.FlagIsSpecified(clasp.VersionFlag()) {
+		if section := section_of_(&spec); 0 != len(section) {
 
-				clasp.ShowVersion(parse_params.Specifications, clasp.UsageParams{
-
-					Version:       cl.Version,
-					VersionPrefix: cl.VersionPrefix,
-					Stream:        stream,
-					Exiter:        exiter,
-					ProgramName:   arguments.ProgramName,
-				})
-			}
+			negation = negation.SetExtra(_libCLImate_Section, section)
 		}
 
-		for i := 0; i != len(arguments.Arguments); i++ {
+		cl.append_specification_(&negation)
+	}
+}
 
-			var argument *clasp.Argument = arguments.Arguments[i]
-			var alias *clasp.Specification = argument.ArgumentSpecification
+// Invokes the given function, collecting any error, or defers it if the
+// specification is qualified by AliasFlag_CallbackAfterVerify.
+func invoke_or_defer_(fn func() error, spec *clasp.Specification, deferred *[]func() error, callbackErrors *[]error) {
 
-			if alias != nil {
+	if 0 != (AliasFlag_CallbackAfterVerify & alias_flags_of_(spec)) {
 
-				if 0 != len(alias.Extras) {
+		*deferred = append(*deferred, fn)
+	} else {
 
-					if ff, ff_ok := alias.Extras[_libCLImate_FlagFunc]; ff_ok {
+		if err := fn(); err != nil {
 
-						switch fn := ff.(type) {
+			*callbackErrors = append(*callbackErrors, err)
+		}
+	}
+}
 
-						case FlagFunc:
+// Invokes the flag function, if any, of the given specification for the
+// given argument, or defers it if the specification is qualified by
+// AliasFlag_CallbackAfterVerify.
+func invoke_flag_func_(argument *clasp.Argument, spec *clasp.Specification, deferred *[]func() error, callbackErrors *[]error) {
 
-							fn()
+	if ff, ff_ok := spec.Extras[_libCLImate_FlagFunc]; ff_ok {
 
-							argument.Use()
-						default:
+		switch fn := ff.(type) {
 
-							// Issue warning
-						}
-					}
+		case FlagFunc:
 
-					if of, of_ok := alias.Extras[_libCLImate_OptionFunc]; of_ok {
+			invoke_or_defer_(func() error {
 
-						switch fn := of.(type) {
+				fn()
 
-						case OptionFunc:
+				return nil
+			}, spec, deferred, callbackErrors)
 
-							fn(argument, alias)
+			argument.Use()
+		case FlagErrorFunc:
 
-							argument.Use()
-						default:
+			invoke_or_defer_(fn, spec, deferred, callbackErrors)
 
-							// Issue warning
-						}
-					}
-				}
-			}
+			argument.Use()
 		}
 	}
+}
 
-	if err != nil {
+// Invokes the option function, if any, of the given specification for the
+// given argument, or defers it if the specification is qualified by
+// AliasFlag_CallbackAfterVerify.
+func invoke_option_func_(argument *clasp.Argument, spec *clasp.Specification, deferred *[]func() error, callbackErrors *[]error) {
 
-		if 0 != (ParseFlag_PanicOnFailure & parseFlags) {
+	if of, of_ok := spec.Extras[_libCLImate_OptionFunc]; of_ok {
 
-			panic(err)
-		}
-	} else {
+		switch fn := of.(type) {
 
-		result = Result{
+		case OptionFunc:
 
-			Flags:   arguments.Flags,
-			Options: arguments.Options,
+			invoke_or_defer_(func() error {
+
+				fn(argument, spec)
+
+				return nil
+			}, spec, deferred, callbackErrors)
+
+			argument.Use()
+		case OptionErrorFunc:
+
+			invoke_or_defer_(func() error {
+
+				return fn(argument, spec)
+			}, spec, deferred, callbackErrors)
+
+			argument.Use()
+		}
+	}
+}
+
+// Parses a command line, obtaining a Result instance representing the
+// arguments received by the process.
+//
+// Any option that has a default value (see
+// [clasp.Specification.SetDefaultValue]) but is not specified is
+// materialised in the result with that value, and may be distinguished
+// via [Result.OptionIsDefault].
+//
+// Any errors returned by flag/option functions (see [FlagErrorFunc] and
+// [OptionErrorFunc]) are joined and returned, along with the result, or,
+// if ParseFlag_ReportCallbackErrors is specified, the first is reported in
+// the same manner as by [Climate.Abort].
+//
+// Unless InitFlag_NoHelpFlag is specified, "--help=json" causes the usage
+// to be written as a JSON document (see [Climate.WriteHelpJSON]),
+// "--help=man" as a man page (see [Climate.WriteManPage]), and
+// "--help=markdown" as a Markdown document (see [Climate.WriteMarkdown]).
+// (The help for a flag/option having one of these names, e.g. "--json",
+// is therefore shown only by its full name, as in "--help=--json".)
+//
+// Unless InitFlag_NoVersionFlag is specified, "--version=verbose" causes
+// the version to be shown along with any VCS information, the Go version,
+// and the module dependencies (including this library); and
+// "--version=json" causes the version to be written as a JSON document
+// (see [Climate.WriteVersionJSON]).
+func (cl Climate) Parse(argv []string, options ...any) (result Result, err error) {
+
+	var parseFlags ParseFlag
+	var outStream io.Writer
+	var errStream io.Writer
+	var exiter internal.Exiter
+	var arguments *clasp.Arguments
+	var deferred []func() error
+	var callbackErrors []error
+	var defaults []*clasp.Argument
+	var bindErrors []error
+
+	valueNames, valuesConstraint, _ := cl.values_()
+
+	if err == nil {
+
+		parseFlags, err = parse_ParseFlags_from_options_(options...)
+	}
+
+	if err == nil {
+
+		outStream, errStream, err = parse_Streams_from_options_(options...)
+	}
+	if err == nil && outStream == nil {
+
+		if cl.outStream != nil {
+
+			outStream = cl.outStream
+		} else {
+
+			outStream = os.Stdout
+		}
+	}
+	if err == nil && errStream == nil {
+
+		if cl.errStream != nil {
+
+			errStream = cl.errStream
+		} else {
+
+			errStream = os.Stderr
+		}
+	}
+
+	if err == nil {
+
+		exiter, err = parse_Exiter_from_options_(options...)
+	}
+	if err == nil && exiter == nil {
+
+		exiter = cl.exiter
+	}
+
+	if err == nil {
+
+		parse_params := clasp.ParseParams{
+
+			Specifications: parse_specifications_(cl.Specifications),
+		}
+
+		// A help form, as in "--help=json", or a version form, as in
+		// "--version=verbose", is handled separately
+
+		parse_argv := argv
+		help_form, has_help_form := "", false
+		version_form, has_version_form := "", false
+
+		if 0 == (cl.initFlags & InitFlag_NoHelpFlag) {
+
+			help_form, parse_argv, has_help_form = find_form_argument_(parse_argv, clasp.HelpFlag().Name)
+		}
+
+		if 0 == (cl.initFlags & InitFlag_NoVersionFlag) {
+
+			version_form, parse_argv, has_version_form = find_form_argument_(parse_argv, clasp.VersionFlag().Name)
+		}
+
+		arguments = clasp.Parse(expand_macro_aliases_(parse_argv, cl.Specifications), parse_params)
+
+		// The colour option is processed first, so that it applies to the
+		// usage and to any diagnostics
+
+		if 0 != (cl.initFlags&InitFlag_ColorOption) && cl.colorOption != nil {
+
+			*cl.colorOption = ""
+
+			for _, option := range arguments.Options {
+
+				if colorOptionName_ != option.ResolvedName {
+
+					continue
+				}
+
+				option.Use()
+
+				if _, color_err := parse_color_mode_(option.Value); color_err != nil {
+
+					cl.abort_(errStream, exiter, color_err.Error(), nil)
+				} else {
+
+					*cl.colorOption = option.Value
+				}
+			}
+		}
+
+		if 0 == (cl.initFlags & InitFlag_NoHelpFlag) {
+
+			if has_help_form {
+
+				switch help_form {
+
+				case "json":
+
+					cl.exit_after_render_(errStream, exiter, "usage", write_json_(outStream, cl.help_document_(arguments.ProgramName)))
+				case "man":
+
+					cl.exit_after_render_(errStream, exiter, "usage", ManPageUsageRenderer{}.RenderUsage(outStream, cl.usage_model_(arguments.ProgramName, io.Discard)))
+				case "markdown":
+
+					cl.exit_after_render_(errStream, exiter, "usage", MarkdownUsageRenderer{}.RenderUsage(outStream, cl.usage_model_(arguments.ProgramName, io.Discard)))
+				default:
+
+					if !cl.show_help_form_(outStream, errStream, exiter, arguments.ProgramName, help_form) {
+
+						cl.abort_(errStream, exiter, fmt.Sprintf("unrecognised %s form '%s'", clasp.HelpFlag().Name, help_form), nil)
+					}
+				}
+			} else if arguments.FlagIsSpecified(clasp.HelpFlag()) {
+
+				// A flag/option specified after "--help", as in "--help
+				// --verbosity", shows the help for just that
+
+				shown := false
+				help, _ := arguments.LookupFlag(clasp.HelpFlag())
+
+				for _, argument := range arguments.Arguments {
+
+					if argument.CmdLineIndex <= help.CmdLineIndex {
+
+						continue
+					}
+
+					if clasp.ValueType == argument.Type || clasp.HelpFlag().Name == argument.ResolvedName || colorOptionName_ == argument.ResolvedName {
+
+						continue
+					}
+
+					if argument.ArgumentSpecification != nil {
+
+						argument.Use()
+
+						shown = cl.show_help_form_(outStream, errStream, exiter, arguments.ProgramName, argument.ResolvedName)
+					}
+
+					break
+				}
+
+				if !shown {
+
+					cl.exit_after_render_(errStream, exiter, "usage", cl.usage_renderer_().RenderUsage(outStream, cl.usage_model_(arguments.ProgramName, outStream)))
+				}
+			}
+		}
+
+		if 0 == (cl.initFlags & InitFlag_NoVersionFlag) {
+
+			params := usage_params_{
+
+				Version:       cl.Version,
+				VersionPrefix: cl.VersionPrefix,
+				Stream:        outStream,
+				Exiter:        exiter,
+				ProgramName:   arguments.ProgramName,
+			}
+
+			if has_version_form {
+
+				switch version_form {
+
+				case "verbose":
+
+					show_version_verbose_(params)
+				case "json":
+
+					cl.exit_after_render_(errStream, exiter, "version", write_json_(outStream, cl.version_document_(arguments.ProgramName)))
+				default:
+
+					cl.abort_(errStream, exiter, fmt.Sprintf("unrecognised %s form '%s'", clasp.VersionFlag().Name, version_form), nil)
+				}
+			} else if arguments.FlagIsSpecified(clasp.VersionFlag()) {
+
+				cl.exit_after_render_(errStream, exiter, "version", cl.usage_renderer_().RenderVersion(outStream, cl.usage_model_(arguments.ProgramName, outStream)))
+			}
+		}
+
+		for i := 0; i != len(arguments.Arguments); i++ {
+
+			var argument *clasp.Argument = arguments.Arguments[i]
+			var alias *clasp.Specification = argument.ArgumentSpecification
+
+			if alias != nil {
+
+				aliasFlags := alias_flags_of_(alias)
+
+				if _, is_negation := negation_of_(alias); is_negation {
+
+					argument.Use()
+				}
+
+				if 0 != (AliasFlag_Deprecated & aliasFlags) {
+
+					cl.warn_(errStream, exiter, fmt.Sprintf("%s is deprecated", alias.Name), nil)
+				}
+
+				if clasp.OptionType == argument.Type {
+
+					if flags, is_path := path_flags_of_(argument.ResolvedName, cl.Specifications); is_path {
+
+						argument.Value = expand_path_(argument.Value, flags)
+					}
+				}
+
+				if bind_err := bind_argument_(argument, alias, cl.Specifications); bind_err != nil {
+
+					bindErrors = append(bindErrors, bind_err)
+				}
+
+				if 0 != len(alias.Extras) {
+
+					invoke_flag_func_(argument, alias, &deferred, &callbackErrors)
+					invoke_option_func_(argument, alias, &deferred, &callbackErrors)
+				}
+			}
+		}
+
+		// Apply the default values of any options not specified
+
+		for _, spec := range cl.Specifications {
+
+			if clasp.OptionType != spec.Type || 0 == len(spec.DefaultValue) {
+
+				continue
+			}
+
+			if option_is_specified_(arguments, spec) {
+
+				continue
+			}
+
+			value := spec.DefaultValue
+
+			if flags, is_path := spec.Extras[_libCLImate_Path].(PathFlag); is_path {
+
+				value = expand_path_(value, flags)
+			}
+
+			argument := &clasp.Argument{
+
+				ResolvedName:          spec.Name,
+				GivenName:             spec.Name,
+				Value:                 value,
+				Type:                  clasp.OptionType,
+				CmdLineIndex:          -1,
+				ArgumentSpecification: spec,
+			}
+
+			argument.Use()
+
+			arguments.Options = append(arguments.Options, argument)
+			defaults = append(defaults, argument)
+
+			if bind_err := bind_argument_(argument, spec, cl.Specifications); bind_err != nil {
+
+				bindErrors = append(bindErrors, bind_err)
+			}
+
+			if 0 != (ParseFlag_CallbackDefaultedOptions & parseFlags) {
+
+				invoke_option_func_(argument, spec, &deferred, &callbackErrors)
+			}
+		}
+	}
+
+	if err == nil {
+
+		expand_path_values_(arguments.Values, cl.ValueSpecifications)
+	}
+
+	if err != nil {
+
+		if 0 != (ParseFlag_PanicOnFailure & parseFlags) {
+
+			panic(err)
+		}
+	} else {
+
+		result = Result{
+
+			Flags:   arguments.Flags,
+			Options: arguments.Options,
 			Values:  arguments.Values,
 
 			ProgramName: arguments.ProgramName,
@@ -467,18 +1227,43 @@ func (cl Climate) Parse(argv []string, options ...any) (result Result, err error
 
 			arguments:        arguments,
 			parseFlags:       parseFlags,
-			stream:           stream,
+			errStream:        errStream,
 			exiter:           exiter,
-			valueNames:       cl.ValueNames,
-			valuesConstraint: cl.ValuesConstraint,
-			usageHelpSuffix:  cl.UsageHelpSuffix,
+			specifications:   cl.Specifications,
+			valueNames:       valueNames,
+			valuesConstraint: valuesConstraint,
+			usageHelpSuffix:  cl.expand_placeholders_(cl.UsageHelpSuffix, arguments.ProgramName),
+			deferred:         deferred,
+			defaults:         defaults,
+			bindErrors:       bindErrors,
+			valueSpecs:       cl.ValueSpecifications,
+			resultValidators: cl.resultValidators,
+			colorMode:        cl.color_mode_(),
+		}
+
+		// Return, or report, any errors returned by flag/option functions
+
+		if 0 != len(callbackErrors) {
+
+			if 0 != (ParseFlag_ReportCallbackErrors & parseFlags) {
+
+				cl.abort_(errStream, exiter, callbackErrors[0].Error(), nil)
+			} else {
+
+				err = errors.Join(callbackErrors...)
+
+				if 0 != (ParseFlag_PanicOnFailure & parseFlags) {
+
+					panic(err)
+				}
+			}
 		}
 	}
 
 	return
 }
 
-func (result Result) validateValues1(stream io.Writer, constraint int) {
+func (result Result) validateValues1(stream io.Writer, constraint int) bool {
 
 	n := len(result.Values)
 
@@ -486,9 +1271,11 @@ func (result Result) validateValues1(stream io.Writer, constraint int) {
 		// do not validate
 	} else {
 		if constraint < n {
-			fmt.Fprintf(result.stream, "%s: too many values%s\n", result.ProgramName, uhs_(result.usageHelpSuffix))
+			fmt.Fprintf(stream, "%s too many values%s\n", result.prefix_(stream), uhs_(result.usageHelpSuffix))
 
 			result.exiter.Exit(1)
+
+			return false
 		}
 		if constraint > n {
 			var value_name string
@@ -498,25 +1285,31 @@ func (result Result) validateValues1(stream io.Writer, constraint int) {
 				value_name = fmt.Sprintf("value-%d", n)
 			}
 
-			fmt.Fprintf(result.stream, "%s: %s not specified%s\n", result.ProgramName, value_name, uhs_(result.usageHelpSuffix))
+			fmt.Fprintf(stream, "%s %s not specified%s\n", result.prefix_(stream), value_name, uhs_(result.usageHelpSuffix))
 
 			result.exiter.Exit(1)
+
+			return false
 		}
 	}
+
+	return true
 }
 
-func (result Result) validateValues2(stream io.Writer, min, max int) {
+func (result Result) validateValues2(stream io.Writer, min, max int) bool {
 
 	if min == max {
-		result.validateValues1(stream, min)
+		return result.validateValues1(stream, min)
 	} else {
 
 		n := len(result.Values)
 
 		if max > 0 && max < n {
-			fmt.Fprintf(result.stream, "%s: too many values%s\n", result.ProgramName, uhs_(result.usageHelpSuffix))
+			fmt.Fprintf(stream, "%s too many values%s\n", result.prefix_(stream), uhs_(result.usageHelpSuffix))
 
 			result.exiter.Exit(1)
+
+			return false
 		}
 
 		if min > 0 && min > n {
@@ -527,23 +1320,110 @@ func (result Result) validateValues2(stream io.Writer, min, max int) {
 				value_name = fmt.Sprintf("value-%d", n)
 			}
 
-			fmt.Fprintf(result.stream, "%s: %s not specified%s\n", result.ProgramName, value_name, uhs_(result.usageHelpSuffix))
+			fmt.Fprintf(stream, "%s %s not specified%s\n", result.prefix_(stream), value_name, uhs_(result.usageHelpSuffix))
+
+			result.exiter.Exit(1)
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// Verifies that each flag/option that is qualified by AliasFlag_Required
+// is specified (or, for an option, has a default value), and that each
+// flag/option qualified by AliasFlag_Single is not specified more than
+// once.
+func (result Result) validateRequiredAndRepeatable(stream io.Writer) bool {
+
+	counts := make(map[string]int)
+
+	for _, argument := range result.arguments.Arguments {
+
+		if argument.ArgumentSpecification != nil && clasp.ValueType != argument.Type {
+
+			if name, is_negation := negation_of_(argument.ArgumentSpecification); is_negation {
+
+				counts[name]++
+			} else {
+
+				counts[argument.ArgumentSpecification.Name]++
+			}
+		}
+	}
+
+	// An option that is not specified, but is given its default value, is
+	// present
+
+	materialised := make(map[string]bool)
+
+	for _, option := range result.arguments.Options {
+
+		if option.CmdLineIndex < 0 {
+
+			materialised[option.ResolvedName] = true
+		}
+	}
+
+	for _, spec := range result.specifications {
+
+		if _, is_negation := negation_of_(spec); is_negation {
+
+			continue
+		}
+
+		aliasFlags := alias_flags_of_(spec)
+		n := counts[spec.Name]
+
+		if 0 != (AliasFlag_Required&aliasFlags) && 0 == n && !materialised[spec.Name] {
+
+			var spec_type string
+			if clasp.OptionType == spec.Type {
+				spec_type = "option"
+			} else {
+				spec_type = "flag"
+			}
+
+			fmt.Fprintf(stream, "%s required %s %s not specified%s\n", result.prefix_(stream), spec_type, spec.Name, uhs_(result.usageHelpSuffix))
+
+			result.exiter.Exit(1)
+
+			return false
+		}
+
+		if 0 != (AliasFlag_Single&aliasFlags) && 1 < n {
+
+			fmt.Fprintf(stream, "%s %s specified more than once%s\n", result.prefix_(stream), spec.Name, uhs_(result.usageHelpSuffix))
 
 			result.exiter.Exit(1)
+
+			return false
 		}
 	}
+
+	return true
 }
 
 // Verifies that all given arguments received are recognised according to
-// the specified flags and options
+// the specified flags and options, that all required flags/options are
+// specified, that no flag/option qualified by AliasFlag_Single is
+// repeated, that the values of any bound variables (see [OptionVar]) were
+// converted, that the values of any path-valued options (see
+// [PathOption]) satisfy their path flags, that the values of any validated
+// options (see [ValidatedOption]) satisfy their validators, that the
+// values satisfy any constraints, and that each value satisfies its value
+// specification (see [Climate.AddValue]), and then that any result
+// validators (see [Climate.AddResultValidator]) succeed; and then invokes
+// any flag/option functions qualified by AliasFlag_CallbackAfterVerify.
 func (result Result) Verify(options ...any) {
 
 	var parseFlags ParseFlag
 
-	stream, _ := parse_Stream_from_options_(options...)
+	_, stream, _ := parse_Streams_from_options_(options...)
 	if stream == nil {
 
-		stream = result.stream
+		stream = result.errStream
 	}
 	if stream == nil {
 
@@ -559,19 +1439,77 @@ func (result Result) Verify(options ...any) {
 
 		if unused := result.arguments.GetUnusedFlagsAndOptions(); 0 != len(unused) {
 
-			fmt.Fprintf(stream, "%s: unrecognised flag/option: %s%s\n", result.arguments.ProgramName, unused[0].Str(), uhs_(result.usageHelpSuffix))
+			fmt.Fprintf(stream, "%s unrecognised flag/option: %s%s\n", result.prefix_(stream), unused[0].Str(), uhs_(result.usageHelpSuffix))
 
 			result.exiter.Exit(1)
+
+			return
 		}
 	}
 
+	if !result.validateRequiredAndRepeatable(stream) {
+
+		return
+	}
+
+	// Report any failure to convert the value of a bound variable
+
+	if 0 != len(result.bindErrors) {
+
+		fmt.Fprintf(stream, "%s %v%s\n", result.prefix_(stream), result.bindErrors[0], uhs_(result.usageHelpSuffix))
+
+		result.exiter.Exit(1)
+
+		return
+	}
+
+	if !result.validatePaths(stream) {
+
+		return
+	}
+
+	if !result.validateOptionValidators(stream) {
+
+		return
+	}
+
 	switch len(result.valuesConstraint) {
 	case 0:
 		// do not validate
 	case 1:
-		result.validateValues1(stream, result.valuesConstraint[0])
+		if !result.validateValues1(stream, result.valuesConstraint[0]) {
+
+			return
+		}
 	default:
-		result.validateValues2(stream, result.valuesConstraint[0], result.valuesConstraint[1])
+		if !result.validateValues2(stream, result.valuesConstraint[0], result.valuesConstraint[1]) {
+
+			return
+		}
+	}
+
+	if !result.validateValueSpecifications(stream) {
+
+		return
+	}
+
+	if !result.validateResultValidators(stream) {
+
+		return
+	}
+
+	// Invoke any flag/option functions deferred until after verification
+
+	for _, fn := range result.deferred {
+
+		if err := fn(); err != nil {
+
+			fmt.Fprintf(stream, "%s %v%s\n", result.prefix_(stream), err, uhs_(result.usageHelpSuffix))
+
+			result.exiter.Exit(1)
+
+			return
+		}
 	}
 }
 
@@ -598,12 +1536,62 @@ func (cl Climate) ParseAndVerify(argv []string, options ...any) (result Result,
 // with a non-0 exit code.
 func (cl Climate) Abort(message string, err error, options ...any) {
 
-	var exiter internal.Exiter
+	stream, exiter := cl.diagnostic_stream_and_exiter_(options...)
+
+	cl.abort_(stream, exiter, message, err)
+}
+
+// Emits the formatted message to the standard error stream, prefixed with
+// the program name, and then terminates the process with a non-0 exit
+// code.
+func (cl Climate) Abortf(format string, args ...any) {
+
+	stream, exiter := cl.diagnostic_stream_and_exiter_()
+
+	cl.abort_(stream, exiter, fmt.Sprintf(format, args...), nil)
+}
+
+// Emits the given message and, optionally, err to the standard error
+// stream, prefixed with the program name and "warning: ", and increments
+// the warning count (see [Climate.NumWarnings]).
+//
+// If the InitFlag_WarningsAreErrors flag was specified to [Init], the
+// warning is instead reported, and the process terminated, as by
+// [Climate.Abort].
+func (cl Climate) Warn(message string, err error, options ...any) {
+
+	stream, exiter := cl.diagnostic_stream_and_exiter_(options...)
+
+	cl.warn_(stream, exiter, message, err)
+}
 
-	stream, _ := parse_Stream_from_options_(options...)
+// Emits the formatted message as a warning, as by [Climate.Warn].
+func (cl Climate) Warnf(format string, args ...any) {
+
+	stream, exiter := cl.diagnostic_stream_and_exiter_()
+
+	cl.warn_(stream, exiter, fmt.Sprintf(format, args...), nil)
+}
+
+// Obtains the number of warnings issued, via [Climate.Warn],
+// [Climate.Warnf], or by the library itself, since the instance was
+// created by [Init].
+func (cl Climate) NumWarnings() int {
+
+	if cl.numWarnings == nil {
+
+		return 0
+	}
+
+	return *cl.numWarnings
+}
+
+func (cl Climate) diagnostic_stream_and_exiter_(options ...any) (stream io.Writer, exiter internal.Exiter) {
+
+	_, stream, _ = parse_Streams_from_options_(options...)
 	if stream == nil {
 
-		stream = cl.stream
+		stream = cl.errStream
 	}
 	if stream == nil {
 
@@ -615,20 +1603,66 @@ func (cl Climate) Abort(message string, err error, options ...any) {
 
 		exiter = cl.exiter
 	}
+	if exiter == nil {
+
+		exiter = new(internal.DefaultExiter)
+	}
+
+	return
+}
 
-	uhs := uhs_(cl.UsageHelpSuffix)
+func (cl Climate) abort_(stream io.Writer, exiter internal.Exiter, message string, err error) {
+
+	uhs := uhs_(cl.expand_placeholders_(cl.UsageHelpSuffix, cl.ProgramName))
 
 	if err != nil {
 
-		fmt.Fprintf(stream, "%s: %s: %v%s\n", cl.ProgramName, message, err, uhs)
+		fmt.Fprintf(stream, "%s %s: %v%s\n", cl.prefix_(stream), message, err, uhs)
 	} else {
 
-		fmt.Fprintf(stream, "%s: %s%s\n", cl.ProgramName, message, uhs)
+		fmt.Fprintf(stream, "%s %s%s\n", cl.prefix_(stream), message, uhs)
 	}
 
 	exiter.Exit(1)
 }
 
+// Terminates the process with an exit code of 0 if the usage or version
+// (as indicated by what) was rendered, or otherwise reports the failure to
+// render it, as by [Climate.Abort].
+func (cl Climate) exit_after_render_(errStream io.Writer, exiter internal.Exiter, what string, err error) {
+
+	if err != nil {
+
+		cl.abort_(errStream, exiter, "failed to render "+what, err)
+	} else {
+
+		exiter.Exit(0)
+	}
+}
+
+func (cl Climate) warn_(stream io.Writer, exiter internal.Exiter, message string, err error) {
+
+	if cl.numWarnings != nil {
+
+		*cl.numWarnings++
+	}
+
+	if 0 != (InitFlag_WarningsAreErrors & cl.initFlags) {
+
+		cl.abort_(stream, exiter, message, err)
+
+		return
+	}
+
+	if err != nil {
+
+		fmt.Fprintf(stream, "%s warning: %s: %v\n", cl.prefix_(stream), message, err)
+	} else {
+
+		fmt.Fprintf(stream, "%s warning: %s\n", cl.prefix_(stream), message)
+	}
+}
+
 // Determines if the given flag is specified
 func (result Result) FlagIsSpecified(id any) bool {
 
@@ -642,11 +1676,70 @@ func (result Result) LookupFlag(id any) (*clasp.Argument, bool) {
 	return result.arguments.LookupFlag(id)
 }
 
+// Obtains the state of the flag with the given id - name, or the
+// specification instance - that is qualified by AliasFlag_Negatable,
+// according to the last-specified of the flag and its negation.
+func (result Result) LookupFlagState(id any) (state FlagState) {
+
+	name := name_of_id_(id)
+	negation := negation_name_(name)
+
+	for _, argument := range result.Flags {
+
+		switch argument.ResolvedName {
+
+		case name:
+
+			argument.Use()
+
+			state = FlagState_Set
+		case negation:
+
+			argument.Use()
+
+			state = FlagState_Unset
+		}
+	}
+
+	return
+}
+
 // Looks for an option with the given id - name, or the specification instance - and
 // returns it and the value true if found; if not, returns nil and false.
+//
+// An option that is not specified but has a default value is found, with
+// that value (see [Result.OptionIsDefault]).
 func (result Result) LookupOption(id any) (*clasp.Argument, bool) {
 
 	return result.arguments.LookupOption(id)
 }
 
+// Indicates whether the option with the given id - name, or the
+// specification instance - was not specified and has been given its
+// default value.
+func (result Result) OptionIsDefault(id any) bool {
+
+	if option, found := result.arguments.LookupOption(id); found {
+
+		return result.ArgumentIsDefault(option)
+	}
+
+	return false
+}
+
+// Indicates whether the given argument was not specified and has been
+// materialised from the default value of its specification.
+func (result Result) ArgumentIsDefault(argument *clasp.Argument) bool {
+
+	for _, d := range result.defaults {
+
+		if d == argument {
+
+			return true
+		}
+	}
+
+	return false
+}
+
 /* ///////////////////////////// end of file //////////////////////////// */
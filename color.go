@@ -0,0 +1,175 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"fmt"
+	"io"
+	"os"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * types
+ */
+
+// Specifies whether the usage and diagnostics are coloured.
+type ColorMode int
+
+// Whether output is styled, i.e. with ANSI escape sequences.
+type style_ bool
+
+/* /////////////////////////////////////////////////////////////////////////
+ * constants
+ */
+
+const (
+	ColorMode_Auto   ColorMode = iota // Output is coloured if the stream is a terminal, unless the NO_COLOR environment variable is set, or if the CLICOLOR_FORCE environment variable is set (to other than "0").
+	ColorMode_Always                  // Output is always coloured.
+	ColorMode_Never                   // Output is never coloured.
+)
+
+const (
+	colorOptionName_ = "--color"
+
+	ansiBold_      = "\x1b[1m"
+	ansiCyan_      = "\x1b[36m"
+	ansiUnderline_ = "\x1b[4m"
+	ansiReset_     = "\x1b[0m"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+func (s style_) apply_(sequence, text string) string {
+
+	if !s || 0 == len(text) {
+
+		return text
+	}
+
+	return sequence + text + ansiReset_
+}
+
+// Styles a heading, e.g. "flags/options:".
+func (s style_) heading_(text string) string {
+
+	return s.apply_(ansiBold_, text)
+}
+
+// Styles a flag/option name, e.g. "--verbose".
+func (s style_) name_(text string) string {
+
+	return s.apply_(ansiCyan_, text)
+}
+
+// Styles a placeholder, e.g. "<value>".
+func (s style_) placeholder_(text string) string {
+
+	return s.apply_(ansiUnderline_, text)
+}
+
+// Parses the given colour mode, which must be one of "auto", "always", and
+// "never".
+func parse_color_mode_(s string) (ColorMode, error) {
+
+	switch s {
+
+	case "auto":
+
+		return ColorMode_Auto, nil
+	case "always":
+
+		return ColorMode_Always, nil
+	case "never":
+
+		return ColorMode_Never, nil
+	default:
+
+		return ColorMode_Auto, fmt.Errorf("invalid %s value '%s' (must be one of auto, always, never)", colorOptionName_, s)
+	}
+}
+
+// Determines whether output to the given stream is coloured in the given
+// colour mode.
+func color_is_enabled_(mode ColorMode, stream io.Writer) bool {
+
+	switch mode {
+
+	case ColorMode_Always:
+
+		return true
+	case ColorMode_Never:
+
+		return false
+	}
+
+	if 0 != len(os.Getenv("NO_COLOR")) {
+
+		return false
+	}
+
+	if force := os.Getenv("CLICOLOR_FORCE"); 0 != len(force) && "0" != force {
+
+		return true
+	}
+
+	if f, ok := stream.(*os.File); ok {
+
+		_, is_terminal := terminal_columns_(f.Fd())
+
+		return is_terminal
+	}
+
+	return false
+}
+
+// Forms the (styled) prefix of a diagnostic, e.g. "myapp:".
+func diagnostic_prefix_(programName string, mode ColorMode, stream io.Writer) string {
+
+	return style_(color_is_enabled_(mode, stream)).apply_(ansiBold_, programName+":")
+}
+
+// Obtains the colour mode, which is that specified by the "--color"
+// option, if any, or else [Climate.Color].
+func (cl Climate) color_mode_() ColorMode {
+
+	if cl.colorOption != nil && 0 != len(*cl.colorOption) {
+
+		if mode, err := parse_color_mode_(*cl.colorOption); err == nil {
+
+			return mode
+		}
+	}
+
+	return cl.Color
+}
+
+func (cl Climate) prefix_(stream io.Writer) string {
+
+	return diagnostic_prefix_(cl.ProgramName, cl.color_mode_(), stream)
+}
+
+func (result Result) prefix_(stream io.Writer) string {
+
+	return diagnostic_prefix_(result.ProgramName, result.colorMode, stream)
+}
+
+// The "--color" option, provided if InitFlag_ColorOption is specified.
+func color_option_() clasp.Specification {
+
+	return clasp.Option(colorOptionName_).
+		SetHelp("Specifies whether the usage and diagnostics are coloured").
+		SetValues("auto", "always", "never")
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
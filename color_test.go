@@ -0,0 +1,146 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"testing"
+)
+
+func color_climate_(options ...any) *libclimate.Climate {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+
+		return nil
+	}, append([]any{libclimate.InitFlag_PanicOnFailure}, options...)...)
+
+	return climate
+}
+
+func color_usage_(t *testing.T, climate *libclimate.Climate, args ...string) string {
+
+	stm := new(bytes.Buffer)
+
+	_, _ = climate.Parse(append([]string{"bin/myapp", "--help"}, args...), stm, internal.StubExiter{})
+
+	return stm.String()
+}
+
+func Test_Color_OPTION_ALWAYS(t *testing.T) {
+
+	t.Setenv("NO_COLOR", "1")
+
+	usage := color_usage_(t, color_climate_(libclimate.InitFlag_ColorOption), "--color=always")
+
+	require.Contains(t, usage, "\x1b[1mUSAGE:\x1b[0m myapp [ ... flags and options ... ]\n")
+	require.Contains(t, usage, "\x1b[1mflags/options:\x1b[0m\n")
+	require.Contains(t, usage, "\t\x1b[36m--debug\x1b[0m\n\t\tRuns in debug mode\n")
+	require.Contains(t, usage, "\t\x1b[36m--color\x1b[0m=\x1b[4m<value>\x1b[0m\n")
+	require.Contains(t, usage, "\t\twhere \x1b[4m<value>\x1b[0m one of:\n")
+}
+
+func Test_Color_OPTION_NEVER(t *testing.T) {
+
+	t.Setenv("CLICOLOR_FORCE", "1")
+
+	usage := color_usage_(t, color_climate_(libclimate.InitFlag_ColorOption), "--color=never")
+
+	require.NotContains(t, usage, "\x1b[")
+}
+
+func Test_Color_OPTION_INVALID(t *testing.T) {
+
+	climate := color_climate_(libclimate.InitFlag_ColorOption)
+
+	climate.ProgramName = "myapp"
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.Parse([]string{"bin/myapp", "--color=sometimes"}, stm, exiter)
+
+	require.Equal(t, "myapp: invalid --color value 'sometimes' (must be one of auto, always, never); use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_Color_OPTION_NOT_PROVIDED(t *testing.T) {
+
+	climate := color_climate_()
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--color=always"}, stm, exiter)
+
+	require.Equal(t, "myapp: unrecognised flag/option: --color=always; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_Color_ENVIRONMENT(t *testing.T) {
+
+	climate := color_climate_()
+
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("CLICOLOR_FORCE", "")
+
+	require.NotContains(t, color_usage_(t, climate), "\x1b[")
+
+	t.Setenv("CLICOLOR_FORCE", "0")
+
+	require.NotContains(t, color_usage_(t, climate), "\x1b[")
+
+	t.Setenv("CLICOLOR_FORCE", "1")
+
+	require.Contains(t, color_usage_(t, climate), "\x1b[1mflags/options:\x1b[0m\n")
+
+	t.Setenv("NO_COLOR", "1")
+
+	require.NotContains(t, color_usage_(t, climate), "\x1b[")
+}
+
+func Test_Color_FIELD(t *testing.T) {
+
+	t.Setenv("NO_COLOR", "1")
+
+	climate := color_climate_(libclimate.InitFlag_ColorOption)
+
+	climate.Color = libclimate.ColorMode_Always
+
+	require.Contains(t, color_usage_(t, climate), "\x1b[1mflags/options:\x1b[0m\n")
+	require.NotContains(t, color_usage_(t, climate, "--color=never"), "\x1b[")
+	require.NotContains(t, color_usage_(t, climate, "--color=auto"), "\x1b[")
+}
+
+func Test_Color_DIAGNOSTICS(t *testing.T) {
+
+	climate := color_climate_(libclimate.InitFlag_ColorOption)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--color=always", "--quiet"}, stm, exiter)
+
+	require.Equal(t, "\x1b[1mmyapp:\x1b[0m unrecognised flag/option: --quiet; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+
+	climate.ProgramName = "myapp"
+
+	stm.Reset()
+
+	climate.Abort("something went wrong", nil, stm, exiter)
+
+	require.Equal(t, "\x1b[1mmyapp:\x1b[0m something went wrong; use --help for usage\n", stm.String())
+
+	stm.Reset()
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--color=never", "--quiet"}, stm, exiter)
+
+	require.Equal(t, "myapp: unrecognised flag/option: --quiet; use --help for usage\n", stm.String())
+}
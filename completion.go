@@ -0,0 +1,237 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * types
+ */
+
+// A word that may be completed, i.e. the name, or an alias, of a
+// flag/option, along with its description.
+type completion_word_ struct {
+	Word        string
+	Description string
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Obtains the words of each of the given sections, comprising the names
+// and aliases of the flags/options, and the aliases of any aliases (each
+// described by its expansion).
+func completion_words_(sections []UsageSection) (words [][]completion_word_) {
+
+	for _, section := range sections {
+
+		var section_words []completion_word_
+
+		for _, spec := range section.Specifications {
+
+			if spec.IsAlias {
+
+				for _, alias := range spec.Aliases {
+
+					section_words = append(section_words, completion_word_{Word: alias, Description: spec.Name})
+				}
+
+				continue
+			}
+
+			for _, alias := range spec.Aliases {
+
+				section_words = append(section_words, completion_word_{Word: alias, Description: spec.Help})
+			}
+
+			section_words = append(section_words, completion_word_{Word: spec.Name, Description: spec.Help})
+
+			for _, alias := range spec.AliasSpecifications {
+
+				for _, a := range alias.Aliases {
+
+					section_words = append(section_words, completion_word_{Word: a, Description: alias.Name})
+				}
+			}
+		}
+
+		words = append(words, section_words)
+	}
+
+	return
+}
+
+// Obtains the name of the completion function for the given program,
+// e.g. "_my_app" for "my-app".
+func completion_function_name_(programName string) string {
+
+	var sb strings.Builder
+
+	sb.WriteRune('_')
+
+	for _, r := range programName {
+
+		if ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') || '_' == r {
+
+			sb.WriteRune(r)
+		} else {
+
+			sb.WriteRune('_')
+		}
+	}
+
+	return sb.String()
+}
+
+// Obtains the given text quoted for the shell, by single quotes.
+func shell_quote_(s string) string {
+
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func write_bash_completion_(w io.Writer, model UsageModel) {
+
+	fn := completion_function_name_(model.ProgramName)
+
+	fmt.Fprintf(w, "# bash completion for %s\n", model.ProgramName)
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "%s()\n", fn)
+	fmt.Fprintln(w, "{")
+	fmt.Fprintln(w, "\tlocal cur=\"${COMP_WORDS[COMP_CWORD]}\"")
+	fmt.Fprintln(w, "\tlocal words=\"\"")
+
+	for i, section_words := range completion_words_(model.Sections) {
+
+		if 0 == len(section_words) {
+
+			continue
+		}
+
+		fmt.Fprintln(w)
+
+		if title := model.Sections[i].Title; 0 != len(title) {
+
+			fmt.Fprintf(w, "\t# %s\n", title)
+		} else {
+
+			fmt.Fprintln(w, "\t# flags/options")
+		}
+
+		var names []string
+
+		for _, word := range section_words {
+
+			names = append(names, word.Word)
+		}
+
+		fmt.Fprintf(w, "\twords+=%s\n", shell_quote_(" "+strings.Join(names, " ")))
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "\tCOMPREPLY=( $(compgen -W \"${words}\" -- \"${cur}\") )")
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "complete -o default -F %s %s\n", fn, model.ProgramName)
+}
+
+func write_zsh_completion_(w io.Writer, model UsageModel) {
+
+	fn := completion_function_name_(model.ProgramName)
+
+	fmt.Fprintf(w, "#compdef %s\n", model.ProgramName)
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "%s() {\n", fn)
+
+	var describes []string
+
+	for i, section_words := range completion_words_(model.Sections) {
+
+		if 0 == len(section_words) {
+
+			continue
+		}
+
+		title := model.Sections[i].Title
+		if 0 == len(title) {
+
+			title = "flags/options"
+		}
+
+		name := fmt.Sprintf("section_%d", i)
+
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "\tlocal -a %s\n", name)
+		fmt.Fprintf(w, "\t%s=(\n", name)
+
+		for _, word := range section_words {
+
+			entry := strings.ReplaceAll(word.Word, ":", `\:`)
+
+			if 0 != len(word.Description) {
+
+				entry += ":" + word.Description
+			}
+
+			fmt.Fprintf(w, "\t\t%s\n", shell_quote_(entry))
+		}
+
+		fmt.Fprintln(w, "\t)")
+
+		describes = append(describes, fmt.Sprintf("\t_describe -t %s %s %s", strings.ReplaceAll(name, "_", "-"), shell_quote_(title), name))
+	}
+
+	fmt.Fprintln(w)
+
+	for _, describe := range describes {
+
+		fmt.Fprintln(w, describe)
+	}
+
+	fmt.Fprintln(w, "\t_files")
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "%s \"$@\"\n", fn)
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Writes a completion script for the program for the given shell, which
+// must be "bash" or "zsh", that completes the names and aliases of the
+// (non-hidden) flags/options, grouped by section (see [Climate.Section]):
+// for bash, in the order of the sections; and for zsh, as a separately
+// described group for each section.
+func (cl Climate) WriteCompletion(w io.Writer, shell string) error {
+
+	model := cl.usage_model_(cl.ProgramName, io.Discard)
+
+	switch shell {
+
+	case "bash":
+
+		write_bash_completion_(w, model)
+	case "zsh":
+
+		write_zsh_completion_(w, model)
+	default:
+
+		return fmt.Errorf("unsupported completion shell '%s' (must be bash or zsh)", shell)
+	}
+
+	return nil
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
@@ -0,0 +1,73 @@
+package libclimate_test
+
+import (
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"testing"
+)
+
+func Test_Completion_BASH(t *testing.T) {
+
+	stm := new(bytes.Buffer)
+
+	require.Nil(t, documents_climate_().WriteCompletion(stm, "bash"))
+	require.Equal(t, `# bash completion for myapp
+
+_myapp()
+{
+	local cur="${COMP_WORDS[COMP_CWORD]}"
+	local words=""
+
+	# flags/options
+	words+=' --help --version -d --debug'
+
+	# Output options
+	words+=' --verbosity -c'
+
+	COMPREPLY=( $(compgen -W "${words}" -- "${cur}") )
+}
+
+complete -o default -F _myapp myapp
+`, stm.String())
+}
+
+func Test_Completion_ZSH(t *testing.T) {
+
+	stm := new(bytes.Buffer)
+
+	require.Nil(t, documents_climate_().WriteCompletion(stm, "zsh"))
+	require.Equal(t, `#compdef myapp
+
+_myapp() {
+
+	local -a section_0
+	section_0=(
+		'--help:Shows this help and exits'
+		'--version:Shows version information and exits'
+		'-d:Runs in debug mode'
+		'--debug:Runs in debug mode'
+	)
+
+	local -a section_1
+	section_1=(
+		'--verbosity:Specifies the verbosity'
+		'-c:--verbosity=chatty'
+	)
+
+	_describe -t section-0 'flags/options' section_0
+	_describe -t section-1 'Output options' section_1
+	_files
+}
+
+_myapp "$@"
+`, stm.String())
+}
+
+func Test_Completion_UNSUPPORTED(t *testing.T) {
+
+	err := documents_climate_().WriteCompletion(new(bytes.Buffer), "fish")
+
+	require.NotNil(t, err)
+	require.Equal(t, "unsupported completion shell 'fish' (must be bash or zsh)", err.Error())
+}
@@ -0,0 +1,111 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"testing"
+)
+
+func Test_Defaults_NOT_SPECIFIED(t *testing.T) {
+
+	var values []string
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddOptionFunc(clasp.Option("--port").SetHelp("Specifies the port").SetDefaultValue("8080"), func(option *clasp.Argument, _ *clasp.Specification) {
+
+			values = append(values, option.Value)
+		})
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r, _ := climate.ParseAndVerify([]string{"bin/myapp"}, stm, exiter)
+
+	opt, found := r.LookupOption("--port")
+
+	require.True(t, found)
+	require.Equal(t, "8080", opt.Value)
+	require.True(t, r.OptionIsDefault("--port"))
+	require.True(t, r.ArgumentIsDefault(opt))
+	require.Equal(t, 1, len(r.Options))
+	require.Empty(t, values)
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+}
+
+func Test_Defaults_SPECIFIED(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddOption(clasp.Option("--port").SetHelp("Specifies the port").SetDefaultValue("8080"))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r, _ := climate.ParseAndVerify([]string{"bin/myapp", "--port=9090"}, stm, exiter, libclimate.ParseFlag_DontCheckUnused)
+
+	opt, found := r.LookupOption("--port")
+
+	require.True(t, found)
+	require.Equal(t, "9090", opt.Value)
+	require.False(t, r.OptionIsDefault("--port"))
+	require.Equal(t, 1, len(r.Options))
+}
+
+func Test_Defaults_WITH_CallbackDefaultedOptions(t *testing.T) {
+
+	var values []string
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddOptionFunc(clasp.Option("--port").SetHelp("Specifies the port").SetDefaultValue("8080"), func(option *clasp.Argument, _ *clasp.Specification) {
+
+			values = append(values, option.Value)
+		})
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp"}, stm, exiter, libclimate.ParseFlag_CallbackDefaultedOptions)
+
+	require.Equal(t, []string{"8080"}, values)
+
+	values = nil
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--port=9090"}, stm, exiter, libclimate.ParseFlag_CallbackDefaultedOptions)
+
+	require.Equal(t, []string{"9090"}, values)
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+}
+
+func Test_Defaults_ShowUsage(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddOption(clasp.Option("--port").SetHelp("Specifies the port").SetDefaultValue("8080"))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	lines := usage_lines_(t, climate)
+
+	require.Contains(t, lines, "\t--port=<value>")
+	require.Contains(t, lines, "\t\tSpecifies the port (default: 8080)")
+}
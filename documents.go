@@ -0,0 +1,558 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"fmt"
+	"io"
+	"strings"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * types
+ */
+
+// A [UsageRenderer] that renders the usage as a man page (in roff, using
+// the man macros), as is written by "--help=man" (see
+// [Climate.WriteManPage]).
+type ManPageUsageRenderer struct {
+	Section int // The manual section, e.g. 1 for user commands. Defaults to 0, meaning 1.
+}
+
+// A [UsageRenderer] that renders the usage as a Markdown document, as is
+// written by "--help=markdown" (see [Climate.WriteMarkdown]).
+type MarkdownUsageRenderer struct {
+}
+
+// An entry in the flags/options of a document, comprising the forms in
+// which a flag/option (or an alias) may be specified, and its help and
+// allowed values.
+type usage_entry_ struct {
+	Forms  []string
+	Help   string
+	Values []string
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Obtains the entries of each of the given sections, in which an alias is
+// shown alongside the specification to which it resolves (as in the
+// usage).
+func usage_entries_(sections []UsageSection) (entries [][]usage_entry_) {
+
+	for _, section := range sections {
+
+		var section_entries []usage_entry_
+
+		for _, spec := range section.Specifications {
+
+			if spec.IsAlias {
+
+				section_entries = append(section_entries, usage_entry_{
+
+					Forms: []string{strings.Join(spec.Aliases, " ") + " " + spec.Name},
+				})
+
+				continue
+			}
+
+			var entry usage_entry_
+
+			for _, alias := range spec.AliasSpecifications {
+
+				entry.Forms = append(entry.Forms, strings.Join(alias.Aliases, " ")+" "+alias.Name)
+			}
+
+			if clasp.OptionType == spec.Type {
+
+				for _, alias := range spec.Aliases {
+
+					entry.Forms = append(entry.Forms, alias+" <value>")
+				}
+
+				entry.Forms = append(entry.Forms, spec.Name+"=<value>")
+			} else {
+
+				entry.Forms = append(entry.Forms, spec.Aliases...)
+				entry.Forms = append(entry.Forms, spec.Name)
+			}
+
+			entry.Help = usage_help_(spec)
+			entry.Values = spec.Values
+
+			section_entries = append(section_entries, entry)
+		}
+
+		entries = append(entries, section_entries)
+	}
+
+	return
+}
+
+// Obtains the help of the given value specification, as shown in the
+// usage.
+func value_help_(spec ValueSpecification) string {
+
+	help := spec.Help
+
+	if spec.Optional {
+
+		help += " (optional)"
+	}
+
+	return strings.TrimSpace(help)
+}
+
+// Obtains the synopsis, e.g. "myapp [ ... flags and options ... ] <path>".
+func synopsis_(model UsageModel) string {
+
+	if 0 != len(model.ValuesString) {
+
+		return fmt.Sprintf("%s [ ... flags and options ... ] %s", model.ProgramName, model.ValuesString)
+	}
+
+	return fmt.Sprintf("%s [ ... flags and options ... ]", model.ProgramName)
+}
+
+// Escapes the given text for roff, such that hyphens, backslashes, and
+// any leading control character are not interpreted.
+func roff_escape_(s string) string {
+
+	s = strings.ReplaceAll(s, `\`, `\e`)
+	s = strings.ReplaceAll(s, "-", `\-`)
+
+	if strings.HasPrefix(s, ".") || strings.HasPrefix(s, "'") {
+
+		s = `\&` + s
+	}
+
+	return s
+}
+
+// Escapes the given text for Markdown, such that characters that denote
+// emphasis, links, code, or HTML are not interpreted.
+func markdown_escape_(s string) string {
+
+	var sb strings.Builder
+
+	for _, r := range s {
+
+		if strings.ContainsRune("\\`*_[]<>#|", r) {
+
+			sb.WriteRune('\\')
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// Writes the given lines as roff paragraphs, separated at empty lines.
+func write_roff_lines_(w io.Writer, lines []string) {
+
+	for _, line := range lines {
+
+		if 0 == len(strings.TrimSpace(line)) {
+
+			fmt.Fprintln(w, ".PP")
+		} else {
+
+			fmt.Fprintln(w, roff_escape_(line))
+		}
+	}
+}
+
+// Writes the given lines as Markdown paragraphs, separated at empty lines.
+func write_markdown_lines_(w io.Writer, lines []string) {
+
+	for _, line := range lines {
+
+		if 0 == len(strings.TrimSpace(line)) {
+
+			fmt.Fprintln(w)
+		} else {
+
+			fmt.Fprintf(w, "%s  \n", markdown_escape_(line))
+		}
+	}
+
+	fmt.Fprintln(w)
+}
+
+// Writes the given item as a roff tagged paragraph, comprising the tag and
+// (if any) the description.
+func write_roff_item_(w io.Writer, tag, description string) {
+
+	fmt.Fprintln(w, ".TP")
+	fmt.Fprintf(w, ".B %s\n", roff_escape_(tag))
+
+	if 0 != len(description) {
+
+		fmt.Fprintln(w, roff_escape_(description))
+	}
+}
+
+// Writes the given item as a Markdown list item, comprising the code-span
+// tag and (if any) the description.
+func write_markdown_item_(w io.Writer, tag, description string) {
+
+	if 0 != len(description) {
+
+		fmt.Fprintf(w, "* `%s` - %s\n", tag, markdown_escape_(description))
+	} else {
+
+		fmt.Fprintf(w, "* `%s`\n", tag)
+	}
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Renders the usage as a man page, failing only if the writer fails.
+func (r ManPageUsageRenderer) RenderUsage(w io.Writer, model UsageModel) error {
+
+	ew := &error_writer_{w: w}
+
+	w = ew
+
+	section := r.Section
+	if 0 == section {
+
+		section = 1
+	}
+
+	fmt.Fprintf(w, ".TH \"%s\" \"%d\" \"\" \"%s\"\n", strings.ToUpper(model.ProgramName), section, strings.TrimSpace(model.ProgramName+" "+model.Version))
+
+	fmt.Fprintln(w, ".SH NAME")
+
+	var description []string
+
+	for _, line := range model.InfoLines {
+
+		if 0 != len(strings.TrimSpace(line)) || 0 != len(description) {
+
+			description = append(description, line)
+		}
+	}
+
+	if 0 != len(description) {
+
+		fmt.Fprintf(w, "%s \\- %s\n", roff_escape_(model.ProgramName), roff_escape_(description[0]))
+
+		description = description[1:]
+	} else {
+
+		fmt.Fprintln(w, roff_escape_(model.ProgramName))
+	}
+
+	fmt.Fprintln(w, ".SH SYNOPSIS")
+	fmt.Fprintln(w, roff_escape_(synopsis_(model)))
+
+	if 0 != len(strings.TrimSpace(strings.Join(description, ""))) {
+
+		fmt.Fprintln(w, ".SH DESCRIPTION")
+
+		write_roff_lines_(w, description)
+	}
+
+	fmt.Fprintln(w, ".SH OPTIONS")
+
+	for i, entries := range usage_entries_(model.Sections) {
+
+		if 0 != len(model.Sections[i].Title) {
+
+			fmt.Fprintf(w, ".SS %s\n", roff_escape_(model.Sections[i].Title))
+		}
+
+		for _, entry := range entries {
+
+			write_roff_item_(w, strings.Join(entry.Forms, ", "), entry.Help)
+
+			if 0 != len(entry.Values) {
+
+				fmt.Fprintf(w, "where <value> one of: %s\n", roff_escape_(strings.Join(entry.Values, ", ")))
+			}
+		}
+	}
+
+	if 0 != len(model.Values) {
+
+		fmt.Fprintln(w, ".SH VALUES")
+
+		for _, spec := range model.Values {
+
+			tag := "<" + spec.Name + ">"
+			if spec.Variadic {
+
+				tag += " ..."
+			}
+
+			write_roff_item_(w, tag, value_help_(spec))
+		}
+	}
+
+	if 0 != len(model.HelpTopics) {
+
+		fmt.Fprintln(w, ".SH HELP TOPICS")
+
+		for _, topic := range model.HelpTopics {
+
+			write_roff_item_(w, clasp.HelpFlag().Name+"="+topic.Name, topic.Summary)
+		}
+	}
+
+	if 0 != len(model.Examples) {
+
+		fmt.Fprintln(w, ".SH EXAMPLES")
+
+		for _, example := range model.Examples {
+
+			write_roff_item_(w, example.Command, example.Description)
+		}
+	}
+
+	if 0 != len(model.Environment) {
+
+		fmt.Fprintln(w, ".SH ENVIRONMENT")
+
+		for _, variable := range model.Environment {
+
+			write_roff_item_(w, variable.Name, variable.Description)
+		}
+	}
+
+	if 0 != len(model.ExitStatuses) {
+
+		fmt.Fprintln(w, ".SH EXIT STATUS")
+
+		for _, status := range model.ExitStatuses {
+
+			write_roff_item_(w, fmt.Sprint(status.Code), status.Description)
+		}
+	}
+
+	if 0 != len(model.SeeAlso) {
+
+		fmt.Fprintln(w, ".SH SEE ALSO")
+		fmt.Fprintln(w, roff_escape_(strings.Join(model.SeeAlso, ", ")))
+	}
+
+	if 0 != len(model.Epilog) {
+
+		fmt.Fprintln(w, ".SH NOTES")
+
+		write_roff_lines_(w, model.Epilog)
+	}
+
+	return ew.err
+}
+
+// Renders the version, as does [TextUsageRenderer].
+func (ManPageUsageRenderer) RenderVersion(w io.Writer, model UsageModel) error {
+
+	return TextUsageRenderer{}.RenderVersion(w, model)
+}
+
+// Renders the given help topic as a man page section, failing only if
+// the writer fails.
+func (ManPageUsageRenderer) RenderHelpTopic(w io.Writer, model UsageModel, topic HelpTopic) error {
+
+	ew := &error_writer_{w: w}
+
+	w = ew
+
+	fmt.Fprintf(w, ".SH %s\n", roff_escape_(strings.ToUpper(topic.Name)))
+
+	if 0 != len(topic.Summary) {
+
+		fmt.Fprintln(w, roff_escape_(topic.Summary))
+		fmt.Fprintln(w, ".PP")
+	}
+
+	write_roff_lines_(w, topic.Lines)
+
+	return ew.err
+}
+
+// Renders the usage as a Markdown document, failing only if the writer
+// fails.
+func (MarkdownUsageRenderer) RenderUsage(w io.Writer, model UsageModel) error {
+
+	ew := &error_writer_{w: w}
+
+	w = ew
+
+	fmt.Fprintf(w, "# %s\n", markdown_escape_(model.ProgramName))
+	fmt.Fprintln(w)
+
+	if 0 != len(model.InfoLines) {
+
+		write_markdown_lines_(w, model.InfoLines)
+	}
+
+	fmt.Fprintln(w, "## Usage")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "```")
+	fmt.Fprintln(w, synopsis_(model))
+	fmt.Fprintln(w, "```")
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "## Flags/options")
+	fmt.Fprintln(w)
+
+	for i, entries := range usage_entries_(model.Sections) {
+
+		if 0 != len(model.Sections[i].Title) {
+
+			fmt.Fprintf(w, "### %s\n", markdown_escape_(model.Sections[i].Title))
+			fmt.Fprintln(w)
+		}
+
+		for _, entry := range entries {
+
+			description := entry.Help
+
+			if 0 != len(entry.Values) {
+
+				description = strings.TrimSpace(description + " (one of: " + strings.Join(entry.Values, ", ") + ")")
+			}
+
+			write_markdown_item_(w, strings.Join(entry.Forms, "`, `"), description)
+		}
+
+		if 0 != len(entries) {
+
+			fmt.Fprintln(w)
+		}
+	}
+
+	write_section := func(heading string, n int, item func(i int) (string, string)) {
+
+		if 0 == n {
+
+			return
+		}
+
+		fmt.Fprintf(w, "## %s\n", heading)
+		fmt.Fprintln(w)
+
+		for i := 0; i != n; i++ {
+
+			tag, description := item(i)
+
+			write_markdown_item_(w, tag, description)
+		}
+
+		fmt.Fprintln(w)
+	}
+
+	write_section("Values", len(model.Values), func(i int) (string, string) {
+
+		tag := "<" + model.Values[i].Name + ">"
+		if model.Values[i].Variadic {
+
+			tag += " ..."
+		}
+
+		return tag, value_help_(model.Values[i])
+	})
+
+	write_section("Help topics", len(model.HelpTopics), func(i int) (string, string) {
+
+		return clasp.HelpFlag().Name + "=" + model.HelpTopics[i].Name, model.HelpTopics[i].Summary
+	})
+
+	write_section("Examples", len(model.Examples), func(i int) (string, string) {
+
+		return model.Examples[i].Command, model.Examples[i].Description
+	})
+
+	write_section("Environment", len(model.Environment), func(i int) (string, string) {
+
+		return model.Environment[i].Name, model.Environment[i].Description
+	})
+
+	write_section("Exit status", len(model.ExitStatuses), func(i int) (string, string) {
+
+		return fmt.Sprint(model.ExitStatuses[i].Code), model.ExitStatuses[i].Description
+	})
+
+	write_section("See also", len(model.SeeAlso), func(i int) (string, string) {
+
+		return model.SeeAlso[i], ""
+	})
+
+	if 0 != len(model.Epilog) {
+
+		write_markdown_lines_(w, model.Epilog)
+	}
+
+	return ew.err
+}
+
+// Renders the version, as does [TextUsageRenderer].
+func (MarkdownUsageRenderer) RenderVersion(w io.Writer, model UsageModel) error {
+
+	return TextUsageRenderer{}.RenderVersion(w, model)
+}
+
+// Renders the given help topic as a Markdown section, failing only if the
+// writer fails.
+func (MarkdownUsageRenderer) RenderHelpTopic(w io.Writer, model UsageModel, topic HelpTopic) error {
+
+	ew := &error_writer_{w: w}
+
+	w = ew
+
+	fmt.Fprintf(w, "## %s\n", markdown_escape_(topic.Name))
+	fmt.Fprintln(w)
+
+	if 0 != len(topic.Summary) {
+
+		fmt.Fprintln(w, markdown_escape_(topic.Summary))
+		fmt.Fprintln(w)
+	}
+
+	if 0 != len(topic.Lines) {
+
+		write_markdown_lines_(w, topic.Lines)
+	}
+
+	return ew.err
+}
+
+// Writes a man page (in roff, using the man macros) describing the usage
+// of the program, as is written by "--help=man", comprising the sections
+// NAME (from the first of [Climate.InfoLines]), SYNOPSIS, DESCRIPTION
+// (from the remainder of the information lines), OPTIONS (with a
+// subsection for each section - see [Climate.Section]), VALUES, HELP
+// TOPICS, EXAMPLES, ENVIRONMENT, EXIT STATUS, SEE ALSO, and NOTES (from
+// [Climate.Epilog]), each that is empty being omitted.
+func (cl Climate) WriteManPage(w io.Writer) error {
+
+	return ManPageUsageRenderer{}.RenderUsage(w, cl.usage_model_(cl.ProgramName, io.Discard))
+}
+
+// Writes a Markdown document describing the usage of the program, as is
+// written by "--help=markdown", comprising the same sections as the usage
+// (with a subsection of the flags/options for each section - see
+// [Climate.Section]).
+func (cl Climate) WriteMarkdown(w io.Writer) error {
+
+	return MarkdownUsageRenderer{}.RenderUsage(w, cl.usage_model_(cl.ProgramName, io.Discard))
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
@@ -0,0 +1,177 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"testing"
+)
+
+func documents_climate_() *libclimate.Climate {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+		cl.Version = []int{1, 2, 3}
+		cl.InfoLines = []string{"Processes files", "", "Reads each input-file, and writes the result."}
+
+		cl.AddFlag(clasp.Flag("--debug").SetAlias("-d").SetHelp("Runs in debug mode"))
+
+		cl.Section("Output options")
+
+		cl.AddOption(clasp.Option("--verbosity").SetHelp("Specifies the verbosity").SetValues("terse", "chatty"))
+		cl.AddAlias("--verbosity=chatty", "-c")
+
+		cl.AddValue(libclimate.Value("input-file").SetHelp("The input file"))
+
+		cl.AddExample("{{.ProgramName}} -c in.txt", "Processes in.txt chattily")
+		cl.AddEnvironmentVariable("MYAPP_HOME", "The home directory")
+		cl.AddExitStatus(2, "The input-file could not be read")
+		cl.SeeAlso = []string{"otherapp(1)"}
+		cl.Epilog = []string{"Report bugs to *bugs*."}
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	return climate
+}
+
+const documents_man_page_ = `.TH "MYAPP" "1" "" "myapp 1.2.3"
+.SH NAME
+myapp \- Processes files
+.SH SYNOPSIS
+myapp [ ... flags and options ... ] <input\-file>
+.SH DESCRIPTION
+.PP
+Reads each input\-file, and writes the result.
+.SH OPTIONS
+.TP
+.B \-\-help
+Shows this help and exits
+.TP
+.B \-\-version
+Shows version information and exits
+.TP
+.B \-d, \-\-debug
+Runs in debug mode
+.SS Output options
+.TP
+.B \-c \-\-verbosity=chatty, \-\-verbosity=<value>
+Specifies the verbosity
+where <value> one of: terse, chatty
+.SH VALUES
+.TP
+.B <input\-file>
+The input file
+.SH EXAMPLES
+.TP
+.B myapp \-c in.txt
+Processes in.txt chattily
+.SH ENVIRONMENT
+.TP
+.B MYAPP_HOME
+The home directory
+.SH EXIT STATUS
+.TP
+.B 2
+The input\-file could not be read
+.SH SEE ALSO
+otherapp(1)
+.SH NOTES
+Report bugs to *bugs*.
+`
+
+const documents_markdown_ = "# myapp\n" +
+	"\n" +
+	"Processes files  \n" +
+	"\n" +
+	"Reads each input-file, and writes the result.  \n" +
+	"\n" +
+	"## Usage\n" +
+	"\n" +
+	"```\n" +
+	"myapp [ ... flags and options ... ] <input-file>\n" +
+	"```\n" +
+	"\n" +
+	"## Flags/options\n" +
+	"\n" +
+	"* `--help` - Shows this help and exits\n" +
+	"* `--version` - Shows version information and exits\n" +
+	"* `-d`, `--debug` - Runs in debug mode\n" +
+	"\n" +
+	"### Output options\n" +
+	"\n" +
+	"* `-c --verbosity=chatty`, `--verbosity=<value>` - Specifies the verbosity (one of: terse, chatty)\n" +
+	"\n" +
+	"## Values\n" +
+	"\n" +
+	"* `<input-file>` - The input file\n" +
+	"\n" +
+	"## Examples\n" +
+	"\n" +
+	"* `myapp -c in.txt` - Processes in.txt chattily\n" +
+	"\n" +
+	"## Environment\n" +
+	"\n" +
+	"* `MYAPP_HOME` - The home directory\n" +
+	"\n" +
+	"## Exit status\n" +
+	"\n" +
+	"* `2` - The input-file could not be read\n" +
+	"\n" +
+	"## See also\n" +
+	"\n" +
+	"* `otherapp(1)`\n" +
+	"\n" +
+	"Report bugs to \\*bugs\\*.  \n" +
+	"\n"
+
+func Test_ManPage(t *testing.T) {
+
+	climate := documents_climate_()
+
+	stm := new(bytes.Buffer)
+
+	require.Nil(t, climate.WriteManPage(stm))
+	require.Equal(t, documents_man_page_, stm.String())
+
+	help, exitCode := help_of_(t, climate, "--help=man")
+
+	require.Equal(t, documents_man_page_, help)
+	require.Equal(t, 0, exitCode)
+}
+
+func Test_Markdown(t *testing.T) {
+
+	climate := documents_climate_()
+
+	stm := new(bytes.Buffer)
+
+	require.Nil(t, climate.WriteMarkdown(stm))
+	require.Equal(t, documents_markdown_, stm.String())
+
+	help, exitCode := help_of_(t, climate, "--help=markdown")
+
+	require.Equal(t, documents_markdown_, help)
+	require.Equal(t, 0, exitCode)
+}
+
+func Test_MarkdownUsageRenderer_AS_UsageRenderer(t *testing.T) {
+
+	climate := documents_climate_()
+
+	climate.UsageRenderer = libclimate.MarkdownUsageRenderer{}
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.Parse([]string{"bin/myapp", "--help"}, stm, exiter)
+
+	require.Equal(t, documents_markdown_, stm.String())
+	require.Equal(t, 0, exiter.ExitCode)
+}
@@ -0,0 +1,62 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	clasp "github.com/synesissoftware/CLASP.Go"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * constants
+ */
+
+const (
+	_libCLImate_Environment = "_libCLImate_Environment_F73BB1C0_92D7_4cd5_9C36_DB672290CBE7"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Obtains the name of the environment variable associated with the given
+// specification, if any (see [EnvironmentOption]).
+func environment_of_(spec *clasp.Specification) (string, bool) {
+
+	if spec != nil {
+
+		if name, ok := spec.Extras[_libCLImate_Environment].(string); ok {
+
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Associates, for display, an environment variable with the given option,
+// returning the associated specification, which may then be passed to
+// [Climate.AddOption] (or [OptionVar], [PathOption], or
+// [ValidatedOption]), as in:
+//
+//	cl.AddOption(libclimate.EnvironmentOption(clasp.Option("--home").SetHelp("Specifies the home directory"), "MYAPP_HOME"))
+//
+// The variable is shown in the help of the option, including that shown
+// by "--help=<option>", and included in "--help=json". It is not read by
+// [Climate.Parse]: obtaining the value from it is for the program to do.
+func EnvironmentOption(option clasp.Specification, name string) clasp.Specification {
+
+	return option.SetExtra(_libCLImate_Environment, name)
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
@@ -0,0 +1,147 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"fmt"
+	"io"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * types
+ */
+
+// Structure describing an example invocation, shown in the "examples"
+// section of the usage.
+type UsageExample struct {
+	Command     string `json:"command"`     // The command-line, e.g. "myapp --verbose input.txt".
+	Description string `json:"description"` // The description of the example.
+}
+
+// Structure describing an environment variable, shown in the
+// "environment" section of the usage.
+type EnvironmentVariable struct {
+	Name        string `json:"name"`        // The name of the variable, e.g. "MYAPP_HOME".
+	Description string `json:"description"` // The description of the variable.
+}
+
+// Structure describing an exit status, shown in the "exit status" section
+// of the usage.
+type ExitStatus struct {
+	Code        int    `json:"code"`        // The exit code.
+	Description string `json:"description"` // The description of the exit status.
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Shows a list of the usage, comprising the heading and, for each item,
+// its title and (if any) its description, wrapped to the usage width.
+func show_usage_list_(stream io.Writer, heading string, n int, params usage_params_, item func(i int) (title, description string)) {
+
+	if 0 == n {
+
+		return
+	}
+
+	fmt.Fprintf(stream, "%s\n", params.Style.heading_(heading+":"))
+	fmt.Fprintln(stream)
+
+	for i := 0; i != n; i++ {
+
+		title, description := item(i)
+
+		fmt.Fprintf(stream, "\t%s\n", title)
+
+		if 0 != len(description) {
+
+			show_usage_wrapped_(stream, usageHelpIndentation_, description, params.Width)
+		}
+
+		fmt.Fprintln(stream)
+	}
+}
+
+// Shows the help topics, examples, environment, exit status, and see also
+// lists, and the epilog, of the usage.
+func show_usage_epilog_(stream io.Writer, params usage_params_) {
+
+	show_usage_list_(stream, "help topics", len(params.HelpTopics), params, func(i int) (string, string) {
+
+		return clasp.HelpFlag().Name + "=" + params.HelpTopics[i].Name, params.HelpTopics[i].Summary
+	})
+
+	show_usage_list_(stream, "examples", len(params.Examples), params, func(i int) (string, string) {
+
+		return params.Examples[i].Command, params.Examples[i].Description
+	})
+
+	show_usage_list_(stream, "environment", len(params.Environment), params, func(i int) (string, string) {
+
+		return params.Environment[i].Name, params.Environment[i].Description
+	})
+
+	show_usage_list_(stream, "exit status", len(params.ExitStatuses), params, func(i int) (string, string) {
+
+		return fmt.Sprint(params.ExitStatuses[i].Code), params.ExitStatuses[i].Description
+	})
+
+	show_usage_list_(stream, "see also", len(params.SeeAlso), params, func(i int) (string, string) {
+
+		return params.SeeAlso[i], ""
+	})
+
+	for _, line := range params.Epilog {
+
+		fmt.Fprintln(stream, line)
+	}
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Adds an example invocation, with the given description, to the
+// "examples" section of the usage.
+func (cl *Climate) AddExample(command, description string) {
+
+	cl.Examples = append(cl.Examples, UsageExample{
+
+		Command:     command,
+		Description: description,
+	})
+}
+
+// Adds an environment variable, with the given description, to the
+// "environment" section of the usage.
+func (cl *Climate) AddEnvironmentVariable(name, description string) {
+
+	cl.Environment = append(cl.Environment, EnvironmentVariable{
+
+		Name:        name,
+		Description: description,
+	})
+}
+
+// Adds an exit status, with the given description, to the "exit status"
+// section of the usage.
+func (cl *Climate) AddExitStatus(code int, description string) {
+
+	cl.ExitStatuses = append(cl.ExitStatuses, ExitStatus{
+
+		Code:        code,
+		Description: description,
+	})
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
@@ -0,0 +1,92 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func epilog_climate_() *libclimate.Climate {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--verbose").SetHelp("Runs verbosely"))
+
+		cl.AddExample("{{.ProgramName}} --verbose input.txt", "Processes input.txt verbosely")
+		cl.AddEnvironmentVariable("MYAPP_HOME", "Specifies the home directory")
+		cl.AddExitStatus(0, "Success")
+		cl.AddExitStatus(2, "Invalid input")
+		cl.SeeAlso = []string{"otherapp(1)"}
+		cl.Epilog = []string{"Report bugs to <bugs@example.com>"}
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	return climate
+}
+
+func Test_Epilog_USAGE(t *testing.T) {
+
+	climate := epilog_climate_()
+
+	stm := new(bytes.Buffer)
+
+	_, _ = climate.Parse([]string{"bin/myapp", "--help"}, stm, internal.StubExiter{})
+
+	usage := stm.String()
+
+	require.True(t, strings.HasSuffix(usage, `	--verbose
+		Runs verbosely
+
+examples:
+
+	myapp --verbose input.txt
+		Processes input.txt verbosely
+
+environment:
+
+	MYAPP_HOME
+		Specifies the home directory
+
+exit status:
+
+	0
+		Success
+
+	2
+		Invalid input
+
+see also:
+
+	otherapp(1)
+
+Report bugs to <bugs@example.com>
+`), usage)
+}
+
+func Test_Epilog_JSON(t *testing.T) {
+
+	climate := epilog_climate_()
+
+	stm := new(bytes.Buffer)
+
+	require.Nil(t, climate.WriteHelpJSON(stm))
+
+	var doc map[string]any
+
+	require.Nil(t, json.Unmarshal(stm.Bytes(), &doc))
+
+	require.Equal(t, []any{map[string]any{"command": climate.ProgramName + " --verbose input.txt", "description": "Processes input.txt verbosely"}}, doc["examples"])
+	require.Equal(t, []any{map[string]any{"name": "MYAPP_HOME", "description": "Specifies the home directory"}}, doc["environment"])
+	require.Equal(t, 2, len(doc["exit_statuses"].([]any)))
+	require.Equal(t, []any{"otherapp(1)"}, doc["see_also"])
+	require.Equal(t, []any{"Report bugs to <bugs@example.com>"}, doc["epilog"])
+}
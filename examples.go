@@ -0,0 +1,197 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * types
+ */
+
+// The value with which an [example_exiter_] panics, in lieu of exiting.
+type example_exit_ struct {
+	exitCode int
+}
+
+// An exiter that, rather than exiting the process, panics with an
+// [example_exit_], so that processing of an example stops exactly where
+// the program would have exited.
+type example_exiter_ struct {
+}
+
+func (example_exiter_) Exit(exitCode int) {
+
+	panic(example_exit_{exitCode})
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Splits the given command-line into arguments, at whitespace that is not
+// quoted (by single or double quotes) or escaped (by a backslash).
+func split_command_line_(command string) (argv []string, err error) {
+
+	var sb strings.Builder
+	var quote rune
+	in_argument := false
+	escaped := false
+
+	for _, r := range command {
+
+		switch {
+
+		case escaped:
+
+			sb.WriteRune(r)
+
+			escaped = false
+		case '\\' == r && '\'' != quote:
+
+			in_argument = true
+			escaped = true
+		case 0 != quote:
+
+			if quote == r {
+
+				quote = 0
+			} else {
+
+				sb.WriteRune(r)
+			}
+		case '\'' == r || '"' == r:
+
+			in_argument = true
+			quote = r
+		case unicode.IsSpace(r):
+
+			if in_argument {
+
+				argv = append(argv, sb.String())
+
+				sb.Reset()
+				in_argument = false
+			}
+		default:
+
+			sb.WriteRune(r)
+
+			in_argument = true
+		}
+	}
+
+	if escaped || 0 != quote {
+
+		return nil, fmt.Errorf("unterminated quote or escape in command-line '%s'", command)
+	}
+
+	if in_argument {
+
+		argv = append(argv, sb.String())
+	}
+
+	return
+}
+
+// Parses and verifies the given argument strings, returning the exit code
+// with which the program would have exited, or -1 if it would not have
+// exited, along with any diagnostic output and any error.
+func (cl Climate) verify_example_(argv []string) (exitCode int, diagnostics string, err error) {
+
+	var errStream bytes.Buffer
+
+	exitCode = -1
+
+	defer func() {
+
+		if r := recover(); r != nil {
+
+			exit, ok := r.(example_exit_)
+			if !ok {
+
+				panic(r)
+			}
+
+			exitCode = exit.exitCode
+		}
+
+		diagnostics = strings.TrimSpace(errStream.String())
+	}()
+
+	_, err = cl.ParseAndVerify(argv, OutputStream{io.Discard}, ErrorStream{&errStream}, example_exiter_{})
+
+	return
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Verifies each of the examples, after expanding any placeholders, by
+// splitting its command into argument strings (honouring quotes and
+// backslash escapes) and parsing and verifying them via
+// [Climate.ParseAndVerify], returning an error describing each example that
+// is rejected, i.e. that would cause the program to exit with a non-0 exit
+// code, or for which an error is returned. An example that would cause the
+// program to exit with exit code 0 - as, for example, does "--help" - is
+// accepted.
+//
+// NOTE: any flag/option functions, and variables bound via [FlagVar] and
+// [OptionVar], are invoked/assigned for each example.
+func (cl Climate) VerifyExamples() error {
+
+	var errs []error
+
+	for _, example := range cl.expand_examples_(cl.ProgramName) {
+
+		argv, err := split_command_line_(example.Command)
+		if err != nil {
+
+			errs = append(errs, fmt.Errorf("example '%s' is rejected: %w", example.Command, err))
+
+			continue
+		}
+
+		if 0 == len(argv) {
+
+			errs = append(errs, fmt.Errorf("example '%s' is rejected: empty command-line", example.Command))
+
+			continue
+		}
+
+		exitCode, diagnostics, err := cl.verify_example_(argv)
+
+		switch {
+
+		case err != nil:
+
+			errs = append(errs, fmt.Errorf("example '%s' is rejected: %w", example.Command, err))
+		case 0 < exitCode:
+
+			if 0 == len(diagnostics) {
+
+				diagnostics = fmt.Sprintf("exit code %d", exitCode)
+			}
+
+			errs = append(errs, fmt.Errorf("example '%s' is rejected: %s", example.Command, diagnostics))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
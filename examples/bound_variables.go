@@ -0,0 +1,47 @@
+// examples/bound_variables.go
+
+package main
+
+import (
+	clasp "github.com/synesissoftware/CLASP.Go"
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+
+	"fmt"
+	"os"
+	"time"
+)
+
+func main() {
+
+	// Specify specifications, parse, and checking standard flags
+
+	is_debug := false
+	port := 8080
+	timeout := time.Duration(0)
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(libclimate.FlagVar(&is_debug, clasp.Flag("--debug").SetAlias("-d").SetHelp("runs in Debug mode")))
+		cl.AddOption(libclimate.OptionVar(&port, clasp.Option("--port").SetAlias("-p").SetHelp("specifies the port")))
+		cl.AddOption(libclimate.OptionVar(&timeout, clasp.Option("--timeout").SetHelp("specifies the timeout").SetDefaultValue("30s")))
+
+		cl.Version = "0.0.1"
+
+		cl.InfoLines = []string{
+			"libCLImate.Go Examples",
+			"",
+			":version:",
+			"",
+		}
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	_, _ = climate.ParseAndVerify(os.Args, libclimate.ParseFlag_PanicOnFailure)
+
+	// Program logic
+
+	fmt.Printf("debug: %v\n", is_debug)
+	fmt.Printf("port: %d\n", port)
+	fmt.Printf("timeout: %v\n", timeout)
+}
@@ -0,0 +1,254 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	"fmt"
+	"io"
+	"strings"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * types
+ */
+
+// Structure describing a named help topic, shown by "--help=<name>" (see
+// [Climate.AddHelpTopic]).
+type HelpTopic struct {
+	Name    string   `json:"name"`    // The name of the topic, e.g. "config".
+	Summary string   `json:"summary"` // The summary of the topic, shown in the "help topics" section of the usage.
+	Lines   []string `json:"lines"`   // The lines of the topic.
+}
+
+// The forms of "--help" that write the usage in another format (see
+// [Climate.Parse]), which therefore may not be the names of help topics.
+var helpFormatForms_ = []string{"json", "man", "markdown"}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Finds the help topic with the given name.
+func find_help_topic_(name string, topics []HelpTopic) (HelpTopic, bool) {
+
+	for _, topic := range topics {
+
+		if name == topic.Name {
+
+			return topic, true
+		}
+	}
+
+	return HelpTopic{}, false
+}
+
+// Finds the (non-alias) specification with the given name or alias,
+// which may be given without its leading hyphen(s), as in "verbosity" for
+// "--verbosity", or with which an alias specification, as in "-c" for
+// "--verbosity=chatty", is associated.
+func find_help_specification_(name string, specs []UsageSpecification) (*UsageSpecification, bool) {
+
+	candidates := []string{name}
+
+	if !strings.HasPrefix(name, "-") {
+
+		candidates = append(candidates, "--"+name, "-"+name)
+	}
+
+	matches := func(spec *UsageSpecification, candidate string) bool {
+
+		if candidate == spec.Name {
+
+			return true
+		}
+
+		for _, alias := range spec.Aliases {
+
+			if candidate == alias {
+
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, candidate := range candidates {
+
+		for i := range specs {
+
+			spec := &specs[i]
+
+			if spec.IsAlias {
+
+				continue
+			}
+
+			if matches(spec, candidate) {
+
+				return spec, true
+			}
+		}
+
+		for i := range specs {
+
+			spec := &specs[i]
+
+			for j := range spec.AliasSpecifications {
+
+				if matches(&spec.AliasSpecifications[j], candidate) {
+
+					return spec, true
+				}
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// Obtains a model, derived from the given model, that comprises only the
+// given specification, along with any aliases that resolve to it and any
+// examples that use it.
+func specification_help_model_(model UsageModel, spec *UsageSpecification) UsageModel {
+
+	names := append([]string{spec.Name}, spec.Aliases...)
+
+	for _, alias := range spec.AliasSpecifications {
+
+		names = append(names, alias.Aliases...)
+	}
+
+	section := UsageSection{
+
+		Title:          spec.Section,
+		Specifications: []UsageSpecification{*spec},
+	}
+
+	var examples []UsageExample
+
+	for _, example := range model.Examples {
+
+		argv, _ := split_command_line_(example.Command)
+
+	arguments:
+		for _, arg := range argv {
+
+			for _, name := range names {
+
+				if name == arg || strings.HasPrefix(arg, name+"=") {
+
+					examples = append(examples, example)
+
+					break arguments
+				}
+			}
+		}
+	}
+
+	return UsageModel{
+
+		ProgramName:  model.ProgramName,
+		Version:      model.Version,
+		ValuesString: model.ValuesString,
+		Sections:     []UsageSection{section},
+		Examples:     examples,
+		Width:        model.Width,
+		Color:        model.Color,
+	}
+}
+
+// Shows the help topic, comprising its name, summary, and lines, each
+// wrapped to the usage width.
+func show_help_topic_(params usage_params_, topic HelpTopic) {
+
+	stream := params.Stream
+
+	fmt.Fprintf(stream, "%s\n", params.Style.heading_(topic.Name+":"))
+	fmt.Fprintln(stream)
+
+	if 0 != len(topic.Summary) {
+
+		show_usage_wrapped_(stream, "\t", topic.Summary, params.Width)
+		fmt.Fprintln(stream)
+	}
+
+	for _, line := range topic.Lines {
+
+		if 0 == len(line) {
+
+			fmt.Fprintln(stream)
+		} else {
+
+			show_usage_wrapped_(stream, "\t", line, params.Width)
+		}
+	}
+}
+
+// Writes the help for the given help form - as in "--help=verbosity" or
+// "--help=config" - which is the name of a help topic, or else the name or
+// alias of a flag/option, and then exits with code 0, returning true; or,
+// if it is neither, returns false.
+func (cl Climate) show_help_form_(stream, errStream io.Writer, exiter internal.Exiter, programName, form string) bool {
+
+	model := cl.usage_model_(programName, stream)
+
+	if topic, found := find_help_topic_(form, model.HelpTopics); found {
+
+		cl.exit_after_render_(errStream, exiter, "usage", cl.usage_renderer_().RenderHelpTopic(stream, model, topic))
+
+		return true
+	}
+
+	var specs []UsageSpecification
+
+	for _, section := range model.Sections {
+
+		specs = append(specs, section.Specifications...)
+	}
+
+	if spec, found := find_help_specification_(form, specs); found {
+
+		cl.exit_after_render_(errStream, exiter, "usage", cl.usage_renderer_().RenderUsage(stream, specification_help_model_(model, spec)))
+
+		return true
+	}
+
+	return false
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Adds a named help topic, shown by "--help=<name>", having the given
+// summary - which is shown, with the name, in the "help topics" section
+// of the usage - and lines, as in:
+//
+//	cl.AddHelpTopic("config", "Describes the configuration file", "The configuration file is read from ~/.myapprc ...")
+//
+// The summary and lines may contain placeholders (see
+// [Climate.AddPlaceholder]). [Init] returns an error if the name is that of
+// another topic, is "json", "man", or "markdown", or is the name or alias
+// of a flag/option (with or without its leading hyphen(s)), since
+// "--help=<name>" would then be ambiguous.
+func (cl *Climate) AddHelpTopic(name, summary string, lines ...string) {
+
+	cl.HelpTopics = append(cl.HelpTopics, HelpTopic{
+
+		Name:    name,
+		Summary: summary,
+		Lines:   append([]string(nil), lines...),
+	})
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
@@ -0,0 +1,305 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func help_climate_() *libclimate.Climate {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+		cl.InfoLines = []string{"My App"}
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+		cl.AddOption(clasp.Option("--verbosity").SetHelp("Specifies the verbosity").SetAlias("-v").SetValues("terse", "chatty").SetDefaultValue("terse"))
+		cl.AddAlias("--verbosity=chatty", "-c")
+
+		cl.AddValue(libclimate.Value("input-file").SetHelp("The input file"))
+
+		cl.AddExample("{{.ProgramName}} --verbosity=chatty in.txt", "Processes in.txt chattily")
+		cl.AddExample("{{.ProgramName}} --debug in.txt", "Processes in.txt in debug mode")
+		cl.AddExample("{{.ProgramName}} -c in.txt", "")
+
+		cl.AddHelpTopic("config", "Describes the configuration file", "The configuration file of {{.ProgramName}} is read from", "~/.myapprc", "", "It is optional.")
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	return climate
+}
+
+func help_of_(t *testing.T, climate *libclimate.Climate, args ...string) (string, int) {
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.Parse(append([]string{"bin/myapp"}, args...), stm, exiter)
+
+	return stm.String(), exiter.ExitCode
+}
+
+const help_verbosity_ = `USAGE: myapp [ ... flags and options ... ] <input-file>
+
+flags/options:
+
+	-c --verbosity=chatty
+	-v <value>
+	--verbosity=<value>
+		Specifies the verbosity (default: terse)
+		where <value> one of:
+			terse
+			chatty
+
+examples:
+
+	myapp --verbosity=chatty in.txt
+		Processes in.txt chattily
+
+	myapp -c in.txt
+
+`
+
+func Test_Help_SPECIFICATION(t *testing.T) {
+
+	climate := help_climate_()
+
+	for _, args := range [][]string{
+		{"--help=verbosity"},
+		{"--help=--verbosity"},
+		{"--help=-v"},
+		{"--help=v"},
+		{"--help=-c"},
+		{"--help", "--verbosity=terse"},
+		{"--debug", "--help", "-v", "chatty"},
+	} {
+
+		help, exitCode := help_of_(t, climate, args...)
+
+		require.Equal(t, help_verbosity_, help, "%v", args)
+		require.Equal(t, 0, exitCode)
+	}
+}
+
+func Test_Help_SPECIFICATION_FLAG(t *testing.T) {
+
+	help, exitCode := help_of_(t, help_climate_(), "--help", "--debug")
+
+	require.Equal(t, `USAGE: myapp [ ... flags and options ... ] <input-file>
+
+flags/options:
+
+	--debug
+		Runs in debug mode
+
+examples:
+
+	myapp --debug in.txt
+		Processes in.txt in debug mode
+
+`, help)
+	require.Equal(t, 0, exitCode)
+}
+
+func Test_Help_SPECIFICATION_ORDER(t *testing.T) {
+
+	climate := help_climate_()
+
+	full, exitCode := help_of_(t, climate, "--help")
+
+	require.Equal(t, 0, exitCode)
+
+	// a flag/option before "--help" does not select the help shown
+
+	help, exitCode := help_of_(t, climate, "--debug", "--help")
+
+	require.Equal(t, full, help)
+	require.Equal(t, 0, exitCode)
+
+	help, exitCode = help_of_(t, climate, "-v", "chatty", "--help")
+
+	require.Equal(t, full, help)
+	require.Equal(t, 0, exitCode)
+
+	// a flag/option after "--help" does
+
+	help, exitCode = help_of_(t, climate, "--help", "--debug")
+
+	require.NotEqual(t, full, help)
+	require.Contains(t, help, "\t--debug\n\t\tRuns in debug mode\n")
+	require.NotContains(t, help, "--verbosity")
+	require.Equal(t, 0, exitCode)
+}
+
+func Test_Help_SPECIFICATION_ENVIRONMENT(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+
+		cl.AddOption(libclimate.EnvironmentOption(clasp.Option("--home").SetHelp("Specifies the home directory"), "MYAPP_HOME"))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	help, exitCode := help_of_(t, climate, "--help=home")
+
+	require.Equal(t, `USAGE: myapp [ ... flags and options ... ]
+
+flags/options:
+
+	--home=<value>
+		Specifies the home directory (environment: MYAPP_HOME)
+
+`, help)
+	require.Equal(t, 0, exitCode)
+}
+
+func Test_EnvironmentOption(t *testing.T) {
+
+	var home string
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddOptionFunc(libclimate.EnvironmentOption(clasp.Option("--home").SetHelp("Specifies the home directory").SetDefaultValue("/tmp"), "MYAPP_HOME"), func(option *clasp.Argument, _ *clasp.Specification) {
+
+			home = option.Value
+		})
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	// the variable is for display only, so is not read by Parse
+
+	t.Setenv("MYAPP_HOME", "/home/me")
+
+	r, _ := climate.ParseAndVerify([]string{"bin/myapp"}, new(bytes.Buffer), &internal.CaptureExiter{ExitCode: -1})
+
+	option, found := r.LookupOption("--home")
+
+	require.True(t, found)
+	require.Equal(t, "/tmp", option.Value)
+	require.True(t, r.OptionIsDefault("--home"))
+	require.Equal(t, "", home)
+
+	r, _ = climate.ParseAndVerify([]string{"bin/myapp", "--home=/opt"}, new(bytes.Buffer), &internal.CaptureExiter{ExitCode: -1})
+
+	option, _ = r.LookupOption("--home")
+
+	require.Equal(t, "/opt", option.Value)
+	require.Equal(t, "/opt", home)
+}
+
+func Test_Help_TOPIC(t *testing.T) {
+
+	climate := help_climate_()
+
+	help, exitCode := help_of_(t, climate, "--help=config")
+
+	require.Equal(t, `config:
+
+	Describes the configuration file
+
+	The configuration file of myapp is read from
+	~/.myapprc
+
+	It is optional.
+`, help)
+	require.Equal(t, 0, exitCode)
+
+	lines := usage_lines_(t, climate)
+
+	require.Contains(t, lines, "help topics:")
+	require.Contains(t, lines, "\t--help=config")
+	require.Contains(t, lines, "\t\tDescribes the configuration file")
+}
+
+func Test_Help_TOPIC_AMBIGUOUS(t *testing.T) {
+
+	_, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+		cl.AddOption(clasp.Option("--verbosity").SetHelp("Specifies the verbosity").SetAlias("-v"))
+		cl.AddAlias("--verbosity=chatty", "-c")
+
+		cl.AddHelpTopic("config", "Describes the configuration file")
+		cl.AddHelpTopic("config", "Describes the configuration file, again")
+		cl.AddHelpTopic("man", "Describes the manual")
+		cl.AddHelpTopic("debug", "Describes debugging")
+		cl.AddHelpTopic("-v", "Describes verbosity")
+		cl.AddHelpTopic("c", "Describes chattiness")
+
+		return nil
+	})
+
+	require.NotNil(t, err)
+	require.ErrorContains(t, err, "duplicate help topic 'config'")
+	require.ErrorContains(t, err, "help topic 'man' collides with the help form '--help=man'")
+	require.ErrorContains(t, err, "help topic 'debug' collides with '--debug'")
+	require.ErrorContains(t, err, "help topic '-v' collides with '--verbosity'")
+	require.ErrorContains(t, err, "help topic 'c' collides with '--verbosity=chatty'")
+}
+
+func Test_Help_SPECIFICATION_NAMED_AS_FORM(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+
+		cl.AddFlag(clasp.Flag("--json").SetHelp("Writes the output as JSON"))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	// "--help=json" is the JSON form of the usage, so the help of "--json"
+	// is shown by its full name
+
+	help, exitCode := help_of_(t, climate, "--help=json")
+
+	require.Equal(t, 0, exitCode)
+	require.Contains(t, help, `"program": "myapp"`)
+
+	help, exitCode = help_of_(t, climate, "--help=--json")
+
+	require.Equal(t, 0, exitCode)
+	require.Contains(t, help, "\t--json\n\t\tWrites the output as JSON\n")
+	require.NotContains(t, help, "Shows this help and exits")
+}
+
+func Test_Help_UNRECOGNISED(t *testing.T) {
+
+	help, exitCode := help_of_(t, help_climate_(), "--help=colour")
+
+	require.Equal(t, "myapp: unrecognised --help form 'colour'; use --help for usage\n", help)
+	require.Equal(t, 1, exitCode)
+}
+
+func Test_Help_JSON(t *testing.T) {
+
+	climate := help_climate_()
+
+	stm := new(bytes.Buffer)
+
+	require.Nil(t, climate.WriteHelpJSON(stm))
+
+	var doc struct {
+		HelpTopics []libclimate.HelpTopic `json:"help_topics"`
+	}
+
+	require.Nil(t, json.Unmarshal(stm.Bytes(), &doc))
+
+	require.Equal(t, []libclimate.HelpTopic{{
+		Name:    "config",
+		Summary: "Describes the configuration file",
+		Lines:   []string{"The configuration file of myapp is read from", "~/.myapprc", "", "It is optional."},
+	}}, doc.HelpTopics)
+}
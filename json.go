@@ -0,0 +1,337 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"encoding/json"
+	"io"
+	"strings"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * types
+ */
+
+// The JSON document produced by "--version=json".
+type version_document_ struct {
+	Program           string   `json:"program"`
+	Version           string   `json:"version"`
+	VersionComponents []string `json:"version_components"`
+}
+
+// The JSON document produced by "--help=json".
+type help_document_ struct {
+	Program           string                `json:"program"`
+	Version           string                `json:"version"`
+	VersionComponents []string              `json:"version_components"`
+	InfoLines         []string              `json:"info_lines"`
+	Specifications    []help_specification_ `json:"specifications"`
+	Sections          []string              `json:"sections"`
+	Aliases           []help_alias_         `json:"aliases"`
+	Values            help_values_          `json:"values"`
+	Examples          []UsageExample        `json:"examples"`
+	Environment       []EnvironmentVariable `json:"environment"`
+	ExitStatuses      []ExitStatus          `json:"exit_statuses"`
+	SeeAlso           []string              `json:"see_also"`
+	Epilog            []string              `json:"epilog"`
+	HelpTopics        []HelpTopic           `json:"help_topics"`
+}
+
+type help_specification_ struct {
+	Type         string   `json:"type"`
+	Name         string   `json:"name"`
+	Aliases      []string `json:"aliases"`
+	Help         string   `json:"help"`
+	Values       []string `json:"values"`
+	DefaultValue string   `json:"default_value"`
+	Environment  string   `json:"environment"`
+	Required     bool     `json:"required"`
+	Repeatable   bool     `json:"repeatable"`
+	Deprecated   bool     `json:"deprecated"`
+	Negatable    bool     `json:"negatable"`
+	Section      string   `json:"section"`
+}
+
+// An alias that resolves to an option-with-value, or to multiple
+// flags/options.
+type help_alias_ struct {
+	Alias     string   `json:"alias"`
+	Expansion []string `json:"expansion"`
+}
+
+type help_values_ struct {
+	ValuesString   string        `json:"values_string"`
+	Minimum        int           `json:"minimum"`
+	Maximum        int           `json:"maximum"`
+	Names          []string      `json:"names"`
+	Specifications []help_value_ `json:"specifications"`
+}
+
+type help_value_ struct {
+	Name     string `json:"name"`
+	Help     string `json:"help"`
+	Type     string `json:"type"`
+	Optional bool   `json:"optional"`
+	Variadic bool   `json:"variadic"`
+	Path     bool   `json:"path"`
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Obtains the components of the given version, e.g. ["0", "1", "2"] for
+// both "0.1.2" and []int{0, 1, 2}.
+func version_components_(version any) []string {
+
+	s := strings.TrimPrefix(version_string_(version, ""), "v")
+
+	if 0 == len(s) {
+
+		return []string{}
+	}
+
+	return strings.Split(s, ".")
+}
+
+func value_type_name_(valueType ValueType) string {
+
+	switch valueType {
+
+	case ValueType_Integer:
+
+		return "integer"
+	case ValueType_Number:
+
+		return "number"
+	case ValueType_Duration:
+
+		return "duration"
+	default:
+
+		return "string"
+	}
+}
+
+// Obtains the minimum and maximum number of values from the given
+// constraint, where -1 means no maximum.
+func values_minimum_and_maximum_(constraint []int) (min, max int) {
+
+	switch len(constraint) {
+
+	case 0:
+
+		return 0, -1
+	case 1:
+
+		if constraint[0] < 0 {
+
+			return 0, -1
+		}
+
+		return constraint[0], constraint[0]
+	default:
+
+		min, max = constraint[0], constraint[1]
+
+		if min < 0 {
+
+			min = 0
+		}
+		if max < 0 {
+
+			max = -1
+		}
+
+		return
+	}
+}
+
+func (cl Climate) version_document_(programName string) version_document_ {
+
+	return version_document_{
+
+		Program:           programName,
+		Version:           version_string_(cl.Version, cl.VersionPrefix),
+		VersionComponents: version_components_(cl.Version),
+	}
+}
+
+func (cl Climate) help_document_(programName string) help_document_ {
+
+	valueNames, valuesConstraint, valuesString := cl.values_()
+
+	doc := help_document_{
+
+		Program:           programName,
+		Version:           version_string_(cl.Version, cl.VersionPrefix),
+		VersionComponents: version_components_(cl.Version),
+		InfoLines:         []string{},
+		Specifications:    []help_specification_{},
+		Sections:          []string{},
+		Aliases:           []help_alias_{},
+		Values: help_values_{
+
+			ValuesString:   valuesString,
+			Names:          append([]string{}, valueNames...),
+			Specifications: []help_value_{},
+		},
+		Examples:     append([]UsageExample{}, cl.expand_examples_(programName)...),
+		Environment:  append([]EnvironmentVariable{}, cl.Environment...),
+		ExitStatuses: append([]ExitStatus{}, cl.ExitStatuses...),
+		SeeAlso:      append([]string{}, cl.SeeAlso...),
+		Epilog:       append([]string{}, cl.expand_placeholder_lines_(cl.Epilog, programName)...),
+		HelpTopics:   append([]HelpTopic{}, cl.expand_help_topics_(programName)...),
+	}
+
+	for _, line := range cl.expand_placeholder_lines_(cl.InfoLines, programName) {
+
+		if ":version:" == line {
+
+			line = programName + " " + doc.Version
+		}
+
+		doc.InfoLines = append(doc.InfoLines, line)
+	}
+
+	// The specifications are those of the usage, so hidden specifications,
+	// and aliases that resolve to them, are omitted
+
+	help_alias := func(alias UsageSpecification) {
+
+		for _, a := range alias.Aliases {
+
+			doc.Aliases = append(doc.Aliases, help_alias_{
+
+				Alias:     a,
+				Expansion: append([]string{}, alias.Expansion...),
+			})
+		}
+	}
+
+	for _, section := range cl.usage_model_(programName, io.Discard).Sections {
+
+		if 0 != len(section.Title) {
+
+			doc.Sections = append(doc.Sections, section.Title)
+		}
+
+		for _, spec := range section.Specifications {
+
+			if spec.IsAlias {
+
+				if spec.IsMacro {
+
+					help_alias(spec)
+				}
+
+				continue
+			}
+
+			hs := help_specification_{
+
+				Type:         "flag",
+				Name:         spec.Name,
+				Aliases:      append([]string{}, spec.Aliases...),
+				Help:         spec.Help,
+				Values:       append([]string{}, spec.Values...),
+				DefaultValue: spec.Default,
+				Environment:  spec.Environment,
+				Required:     spec.Required,
+				Repeatable:   !spec.Single,
+				Deprecated:   spec.Deprecated,
+				Negatable:    spec.Negatable,
+				Section:      spec.Section,
+			}
+
+			if clasp.OptionType == spec.Type {
+
+				hs.Type = "option"
+			}
+
+			// an alias that resolves exactly to this specification is one
+			// of its aliases, and one that resolves to an option-with-value
+			// is described separately
+
+			for _, alias := range spec.AliasSpecifications {
+
+				if alias.Name == spec.Name {
+
+					hs.Aliases = append(hs.Aliases, alias.Aliases...)
+				} else {
+
+					help_alias(alias)
+				}
+			}
+
+			doc.Specifications = append(doc.Specifications, hs)
+		}
+	}
+
+	doc.Values.Minimum, doc.Values.Maximum = values_minimum_and_maximum_(valuesConstraint)
+
+	for _, spec := range cl.ValueSpecifications {
+
+		doc.Values.Specifications = append(doc.Values.Specifications, help_value_{
+
+			Name:     spec.Name,
+			Help:     spec.Help,
+			Type:     value_type_name_(spec.Type),
+			Optional: spec.Optional,
+			Variadic: spec.Variadic,
+			Path:     spec.IsPath,
+		})
+	}
+
+	return doc
+}
+
+func write_json_(w io.Writer, v any) error {
+
+	encoder := json.NewEncoder(w)
+
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(v)
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Writes a JSON document describing the version of the program, as is
+// written by "--version=json", comprising the fields "program", "version",
+// and "version_components".
+func (cl Climate) WriteVersionJSON(w io.Writer) error {
+
+	return write_json_(w, cl.version_document_(cl.ProgramName))
+}
+
+// Writes a JSON document describing the usage of the program, as is written
+// by "--help=json", comprising the fields "program", "version",
+// "version_components", "info_lines", "specifications" (each with "type",
+// "name", "aliases", "help", "values", "default_value", "required",
+// "repeatable", "deprecated", "negatable", and "section"), "sections" (the
+// section titles, in order - see [Climate.Section]), "aliases" (each, of an
+// option-with-value or a macro alias, with "alias" and "expansion"), and
+// "values" (with "values_string", "minimum", "maximum" (-1 meaning no
+// maximum), "names", and "specifications"), "examples" (each with
+// "command" and "description"), "environment" (each with "name" and
+// "description"), "exit_statuses" (each with "code" and "description"),
+// "see_also", "epilog", and "help_topics" (each with "name", "summary", and
+// "lines").
+func (cl Climate) WriteHelpJSON(w io.Writer) error {
+
+	return write_json_(w, cl.help_document_(cl.ProgramName))
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
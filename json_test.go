@@ -0,0 +1,160 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func json_climate_() *libclimate.Climate {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+		cl.Version = []int{0, 1, 2}
+		cl.VersionPrefix = "v"
+		cl.InfoLines = []string{"My App", ":version:"}
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"), libclimate.AliasFlag_Negatable)
+		cl.AddFlag(clasp.Flag("--trace").SetHelp("Traces everything"), libclimate.AliasFlag_Hidden)
+		cl.AddOption(clasp.Option("--verbosity").SetHelp("Specifies the verbosity").SetValues("terse", "chatty").SetDefaultValue("terse"), libclimate.AliasFlag_Required)
+		cl.AddAlias("--debug", "-d")
+		cl.AddAlias("--verbosity=chatty", "-c")
+		cl.AddMacroAlias("-C", "--verbosity=chatty", "--debug")
+
+		cl.AddValue(libclimate.Value("input-file").SetHelp("The input file").SetPath(libclimate.PathFlag_MustExist))
+		cl.AddValue(libclimate.Value("count").SetType(libclimate.ValueType_Integer).SetOptional())
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	return climate
+}
+
+func Test_VersionJSON(t *testing.T) {
+
+	climate := json_climate_()
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.Parse([]string{"bin/myapp", "--version=json"}, stm, exiter)
+
+	require.Equal(t, 0, exiter.ExitCode)
+	require.JSONEq(t, `{"program":"myapp","version":"v0.1.2","version_components":["0","1","2"]}`, stm.String())
+
+	stm.Reset()
+
+	require.Nil(t, climate.WriteVersionJSON(stm))
+	require.JSONEq(t, `{"program":"myapp","version":"v0.1.2","version_components":["0","1","2"]}`, stm.String())
+}
+
+func Test_HelpJSON(t *testing.T) {
+
+	climate := json_climate_()
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.Parse([]string{"bin/myapp", "--help=json"}, stm, exiter)
+
+	require.Equal(t, 0, exiter.ExitCode)
+
+	var doc struct {
+		Program           string   `json:"program"`
+		Version           string   `json:"version"`
+		VersionComponents []string `json:"version_components"`
+		InfoLines         []string `json:"info_lines"`
+		Specifications    []struct {
+			Type         string   `json:"type"`
+			Name         string   `json:"name"`
+			Aliases      []string `json:"aliases"`
+			Help         string   `json:"help"`
+			Values       []string `json:"values"`
+			DefaultValue string   `json:"default_value"`
+			Required     bool     `json:"required"`
+			Negatable    bool     `json:"negatable"`
+		} `json:"specifications"`
+		Aliases []struct {
+			Alias     string   `json:"alias"`
+			Expansion []string `json:"expansion"`
+		} `json:"aliases"`
+		Values struct {
+			ValuesString   string   `json:"values_string"`
+			Minimum        int      `json:"minimum"`
+			Maximum        int      `json:"maximum"`
+			Names          []string `json:"names"`
+			Specifications []struct {
+				Name     string `json:"name"`
+				Type     string `json:"type"`
+				Optional bool   `json:"optional"`
+				Path     bool   `json:"path"`
+			} `json:"specifications"`
+		} `json:"values"`
+	}
+
+	require.Nil(t, json.Unmarshal(stm.Bytes(), &doc))
+
+	require.Equal(t, "myapp", doc.Program)
+	require.Equal(t, "v0.1.2", doc.Version)
+	require.Equal(t, []string{"0", "1", "2"}, doc.VersionComponents)
+	require.Equal(t, []string{"My App", "myapp v0.1.2"}, doc.InfoLines)
+
+	var names []string
+
+	for _, spec := range doc.Specifications {
+
+		names = append(names, spec.Name)
+	}
+
+	require.Equal(t, []string{"--help", "--version", "--debug", "--no-debug", "--verbosity"}, names)
+
+	debug := doc.Specifications[2]
+
+	require.Equal(t, "flag", debug.Type)
+	require.Equal(t, []string{"-d"}, debug.Aliases)
+	require.True(t, debug.Negatable)
+
+	verbosity := doc.Specifications[4]
+
+	require.Equal(t, "option", verbosity.Type)
+	require.Equal(t, "Specifies the verbosity", verbosity.Help)
+	require.Equal(t, []string{"terse", "chatty"}, verbosity.Values)
+	require.Equal(t, "terse", verbosity.DefaultValue)
+	require.True(t, verbosity.Required)
+
+	require.Equal(t, 2, len(doc.Aliases))
+	require.Equal(t, "-c", doc.Aliases[0].Alias)
+	require.Equal(t, []string{"--verbosity=chatty"}, doc.Aliases[0].Expansion)
+	require.Equal(t, "-C", doc.Aliases[1].Alias)
+	require.Equal(t, []string{"--verbosity=chatty", "--debug"}, doc.Aliases[1].Expansion)
+
+	require.Equal(t, "<input-file> [ <count> ]", doc.Values.ValuesString)
+	require.Equal(t, 1, doc.Values.Minimum)
+	require.Equal(t, 2, doc.Values.Maximum)
+	require.Equal(t, []string{"input-file", "count"}, doc.Values.Names)
+	require.Equal(t, 2, len(doc.Values.Specifications))
+	require.True(t, doc.Values.Specifications[0].Path)
+	require.Equal(t, "integer", doc.Values.Specifications[1].Type)
+	require.True(t, doc.Values.Specifications[1].Optional)
+}
+
+func Test_HelpForm_UNRECOGNISED(t *testing.T) {
+
+	climate := json_climate_()
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.Parse([]string{"bin/myapp", "--help=yaml"}, stm, exiter)
+
+	require.Equal(t, "myapp: unrecognised --help form 'yaml'; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
@@ -0,0 +1,54 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+// Package libclimatetest provides test helpers for programs that use
+// libCLImate.Go.
+package libclimatetest
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+
+	"testing"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Verifies, via [libclimate.Climate.VerifyExamples], that each of the
+// examples of the given climate is accepted, failing the test - but
+// continuing its execution - if any is rejected.
+//
+// Example:
+//
+//	func Test_Examples(t *testing.T) {
+//
+//		climate, err := libclimate.Init(initFn)
+//		if err != nil {
+//
+//			t.Fatal(err)
+//		}
+//
+//		libclimatetest.VerifyExamples(t, climate)
+//	}
+func VerifyExamples(t testing.TB, climate *libclimate.Climate) bool {
+
+	t.Helper()
+
+	if err := climate.VerifyExamples(); err != nil {
+
+		t.Errorf("%s", err)
+
+		return false
+	}
+
+	return true
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
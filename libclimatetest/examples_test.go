@@ -0,0 +1,102 @@
+package libclimatetest_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/libclimatetest"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"fmt"
+	"testing"
+)
+
+// A [testing.TB] that records, rather than reports, errors.
+type recording_TB struct {
+	testing.TB
+
+	errors []string
+}
+
+func (tb *recording_TB) Helper() {
+}
+
+func (tb *recording_TB) Errorf(format string, args ...any) {
+
+	tb.errors = append(tb.errors, fmt.Sprintf(format, args...))
+}
+
+func examples_climate_(examples ...string) *libclimate.Climate {
+
+	var verbose bool
+	var level int
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(libclimate.FlagVar(&verbose, clasp.Flag("--verbose")))
+		cl.AddOption(libclimate.OptionVar(&level, clasp.Option("--level")), libclimate.AliasFlag_Required)
+
+		cl.ValuesConstraint = []int{0, 1}
+
+		for _, example := range examples {
+
+			cl.AddExample(example, "")
+		}
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	return climate
+}
+
+func Test_VerifyExamples_ACCEPTED(t *testing.T) {
+
+	climate := examples_climate_(
+		"myapp --level=1",
+		"{{.ProgramName}} --verbose --level=2 'input file.txt'",
+		"myapp --help",
+		"myapp --version",
+	)
+
+	tb := &recording_TB{TB: t}
+
+	require.True(t, libclimatetest.VerifyExamples(tb, climate))
+	require.Empty(t, tb.errors)
+}
+
+func Test_VerifyExamples_REJECTED(t *testing.T) {
+
+	climate := examples_climate_(
+		"myapp --level=1",
+		"myapp --verbose",
+		"myapp --level=1 --quiet",
+		"myapp --level=1 in.txt out.txt",
+		"myapp --level=high",
+	)
+
+	tb := &recording_TB{TB: t}
+
+	require.False(t, libclimatetest.VerifyExamples(tb, climate))
+	require.Equal(t, 1, len(tb.errors))
+
+	message := tb.errors[0]
+
+	require.NotContains(t, message, "'myapp --level=1'")
+	require.Contains(t, message, "example 'myapp --verbose' is rejected: myapp: required option --level not specified; use --help for usage")
+	require.Contains(t, message, "example 'myapp --level=1 --quiet' is rejected: myapp: unrecognised flag/option: --quiet; use --help for usage")
+	require.Contains(t, message, "example 'myapp --level=1 in.txt out.txt' is rejected: myapp: too many values; use --help for usage")
+	require.Contains(t, message, "example 'myapp --level=high' is rejected: myapp: --level 'high' is not a valid integer; use --help for usage")
+}
+
+func Test_VerifyExamples_UNTERMINATED_QUOTE(t *testing.T) {
+
+	climate := examples_climate_(
+		"myapp --level='1",
+	)
+
+	err := climate.VerifyExamples()
+
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "unterminated quote or escape")
+}
@@ -0,0 +1,257 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"fmt"
+	"slices"
+	"strings"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Obtains the name to which the given alias specification resolves,
+// which, for a macro alias, is each of its expansions.
+func alias_resolved_names_(spec *clasp.Specification) []string {
+
+	if expansion, is_macro := spec.Extras[_libCLImate_MacroAlias].([]string); is_macro {
+
+		return expansion
+	}
+
+	return []string{spec.Name}
+}
+
+// Validates the specifications, checking for duplicate names, colliding
+// or malformed aliases, and aliases that resolve to unknown
+// specifications.
+func lint_specifications_(specs []*clasp.Specification) (errs []error) {
+
+	// The specification (by name) of each name and alias
+
+	owners := make(map[string]*clasp.Specification)
+
+	for _, spec := range specs {
+
+		if is_alias_specification_(spec) {
+
+			continue
+		}
+
+		if _, exists := owners[spec.Name]; exists {
+
+			errs = append(errs, fmt.Errorf("duplicate specification '%s'", spec.Name))
+		} else {
+
+			owners[spec.Name] = spec
+		}
+	}
+
+	for _, spec := range specs {
+
+		for _, alias := range spec.Aliases {
+
+			if strings.Contains(alias, "=") {
+
+				errs = append(errs, fmt.Errorf("alias '%s' of '%s' contains '='", alias, spec.Name))
+
+				continue
+			}
+
+			if owner, exists := owners[alias]; exists {
+
+				errs = append(errs, fmt.Errorf("alias '%s' of '%s' collides with '%s'", alias, spec.Name, owner.Name))
+
+				continue
+			}
+
+			owners[alias] = spec
+		}
+
+		if !is_alias_specification_(spec) {
+
+			continue
+		}
+
+		for _, resolved_name := range alias_resolved_names_(spec) {
+
+			name, _, has_value := strings.Cut(resolved_name, "=")
+
+			resolved, exists := owners[name]
+
+			if !exists || is_alias_specification_(resolved) {
+
+				errs = append(errs, fmt.Errorf("alias '%s' resolves to unknown specification '%s'", strings.Join(spec.Aliases, "', '"), name))
+			} else if has_value && clasp.OptionType != resolved.Type {
+
+				errs = append(errs, fmt.Errorf("alias '%s' resolves to '%s', which is not an option", strings.Join(spec.Aliases, "', '"), resolved_name))
+			}
+		}
+	}
+
+	return
+}
+
+// Validates the values-constraint and the value specifications, checking
+// that only the last is variadic, and that no required value follows an
+// optional value.
+func lint_values_(constraint []int, valueSpecs []*ValueSpecification) (errs []error) {
+
+	if 2 < len(constraint) {
+
+		errs = append(errs, fmt.Errorf("values-constraint %v has %d elements (must have 1 or 2)", constraint, len(constraint)))
+	} else {
+
+		for _, n := range constraint {
+
+			if n < -1 {
+
+				errs = append(errs, fmt.Errorf("values-constraint %v has invalid element %d", constraint, n))
+			}
+		}
+
+		if 2 == len(constraint) && 0 <= constraint[1] && constraint[0] > constraint[1] {
+
+			errs = append(errs, fmt.Errorf("values-constraint %v has minimum greater than maximum", constraint))
+		}
+	}
+
+	var optional *ValueSpecification
+
+	for i, spec := range valueSpecs {
+
+		if spec.Variadic && i != len(valueSpecs)-1 {
+
+			errs = append(errs, fmt.Errorf("value '%s' is variadic but is not the last value", spec.Name))
+		}
+
+		if spec.Optional {
+
+			if optional == nil {
+
+				optional = spec
+			}
+		} else if optional != nil {
+
+			errs = append(errs, fmt.Errorf("value '%s' is required but follows optional value '%s'", spec.Name, optional.Name))
+		}
+	}
+
+	return
+}
+
+// Validates the help topics, checking that the name of each is unique, and
+// collides neither with a help form (as in "--help=json") nor with the
+// name or alias of a flag/option (with or without its leading hyphen(s)).
+func lint_help_topics_(topics []HelpTopic, specs []*clasp.Specification) (errs []error) {
+
+	// The flag/option of each name and alias, and each without its leading
+	// hyphen(s)
+
+	owners := make(map[string]string)
+
+	for _, spec := range specs {
+
+		if is_alias_specification_(spec) {
+
+			continue
+		}
+
+		for _, name := range append([]string{spec.Name}, spec.Aliases...) {
+
+			owners[name] = spec.Name
+			owners[strings.TrimLeft(name, "-")] = spec.Name
+		}
+	}
+
+	for _, spec := range specs {
+
+		if is_alias_specification_(spec) {
+
+			for _, alias := range spec.Aliases {
+
+				owners[alias] = spec.Name
+				owners[strings.TrimLeft(alias, "-")] = spec.Name
+			}
+		}
+	}
+
+	names := make(map[string]bool)
+
+	for _, topic := range topics {
+
+		switch {
+
+		case names[topic.Name]:
+
+			errs = append(errs, fmt.Errorf("duplicate help topic '%s'", topic.Name))
+		case slices.Contains(helpFormatForms_, topic.Name):
+
+			errs = append(errs, fmt.Errorf("help topic '%s' collides with the help form '%s=%s'", topic.Name, clasp.HelpFlag().Name, topic.Name))
+		case 0 != len(owners[topic.Name]):
+
+			errs = append(errs, fmt.Errorf("help topic '%s' collides with '%s'", topic.Name, owners[topic.Name]))
+		}
+
+		names[topic.Name] = true
+	}
+
+	return
+}
+
+// Validates the placeholder templates of the info lines, usage-help
+// suffix, epilog, examples, and help topics, checking that each is a valid
+// template that refers only to known placeholders.
+func (cl Climate) lint_placeholders_() (errs []error) {
+
+	lint := func(where, s string) {
+
+		if _, err := cl.try_expand_placeholders_(s, cl.ProgramName); err != nil {
+
+			errs = append(errs, fmt.Errorf("%s '%s' is not a valid template: %v", where, s, err))
+		}
+	}
+
+	for i, line := range cl.InfoLines {
+
+		lint(fmt.Sprintf("info line %d", i), line)
+	}
+
+	lint("usage-help suffix", cl.UsageHelpSuffix)
+
+	for i, line := range cl.Epilog {
+
+		lint(fmt.Sprintf("epilog line %d", i), line)
+	}
+
+	for i, example := range cl.Examples {
+
+		lint(fmt.Sprintf("example %d command", i), example.Command)
+		lint(fmt.Sprintf("example %d description", i), example.Description)
+	}
+
+	for _, topic := range cl.HelpTopics {
+
+		lint(fmt.Sprintf("help topic '%s' summary", topic.Name), topic.Summary)
+
+		for i, line := range topic.Lines {
+
+			lint(fmt.Sprintf("help topic '%s' line %d", topic.Name, i), line)
+		}
+	}
+
+	return
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
@@ -0,0 +1,187 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"testing"
+)
+
+func Test_Lint_VALID(t *testing.T) {
+
+	_, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"), libclimate.AliasFlag_Negatable)
+		cl.AddOption(clasp.Option("--verbosity").SetHelp("Specifies the verbosity").SetValues("terse", "chatty"))
+		cl.AddAlias("--debug", "-d")
+		cl.AddAlias("--verbosity=chatty", "-c")
+		cl.AddMacroAlias("-C", "--verbosity=chatty", "--debug")
+
+		cl.ValuesConstraint = []int{1, -1}
+
+		return nil
+	})
+
+	require.Nil(t, err)
+}
+
+func Test_Lint_VALID_ALIASED_FLAG_WITHOUT_HELP(t *testing.T) {
+
+	climate, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--debug").SetAlias("-d"))
+		cl.AddOption(clasp.Option("--verbosity").SetAlias("-v"))
+
+		return nil
+	})
+
+	require.Nil(t, err)
+
+	lines := usage_lines_(t, climate)
+
+	require.Contains(t, lines, "\t-d")
+	require.Contains(t, lines, "\t--debug")
+	require.Contains(t, lines, "\t-v <value>")
+	require.Contains(t, lines, "\t--verbosity=<value>")
+}
+
+func Test_Lint_DUPLICATE_NAME(t *testing.T) {
+
+	_, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+		cl.AddOption(clasp.Option("--debug").SetHelp("Specifies the debug level"))
+
+		return nil
+	})
+
+	require.NotNil(t, err)
+	require.Equal(t, "duplicate specification '--debug'", err.Error())
+}
+
+func Test_Lint_ALIAS_COLLISIONS(t *testing.T) {
+
+	_, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+		cl.AddFlag(clasp.Flag("--dry-run").SetHelp("Does nothing"))
+		cl.AddAlias("--debug", "-d")
+		cl.AddAlias("--dry-run", "-d")
+		cl.AddAlias("--dry-run", "--help")
+
+		return nil
+	})
+
+	require.NotNil(t, err)
+	require.Equal(t, "alias '-d' of '--dry-run' collides with '--debug'\nalias '--help' of '--dry-run' collides with '--help'", err.Error())
+}
+
+func Test_Lint_ALIAS_CONTAINS_EQUALS(t *testing.T) {
+
+	_, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddOption(clasp.Option("--verbosity").SetHelp("Specifies the verbosity"))
+		cl.AddAlias("--verbosity", "-v=chatty")
+
+		return nil
+	})
+
+	require.NotNil(t, err)
+	require.Equal(t, "alias '-v=chatty' of '--verbosity' contains '='", err.Error())
+}
+
+func Test_Lint_ALIAS_RESOLVES_TO_UNKNOWN(t *testing.T) {
+
+	_, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+		cl.AddAlias("--verbosity=chatty", "-c")
+		cl.AddAlias("--debug=yes", "-d")
+		cl.AddMacroAlias("-X", "--debug", "--trace")
+
+		return nil
+	})
+
+	require.NotNil(t, err)
+	require.Equal(t, "alias '-c' resolves to unknown specification '--verbosity'\nalias '-d' resolves to '--debug=yes', which is not an option\nalias '-X' resolves to unknown specification '--trace'", err.Error())
+}
+
+func Test_Lint_VALUES_CONSTRAINT(t *testing.T) {
+
+	for _, tc := range []struct {
+		constraint []int
+		expected   string
+	}{
+		{[]int{1, 2, 3}, "values-constraint [1 2 3] has 3 elements (must have 1 or 2)"},
+		{[]int{3, 2}, "values-constraint [3 2] has minimum greater than maximum"},
+		{[]int{-2}, "values-constraint [-2] has invalid element -2"},
+	} {
+
+		_, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+			cl.ValuesConstraint = tc.constraint
+
+			return nil
+		})
+
+		require.NotNil(t, err)
+		require.Equal(t, tc.expected, err.Error())
+	}
+}
+
+func Test_Lint_VARIADIC_NOT_LAST(t *testing.T) {
+
+	_, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddValue(libclimate.Value("inputs").SetVariadic())
+		cl.AddValue(libclimate.Value("output"))
+
+		return nil
+	})
+
+	require.NotNil(t, err)
+	require.Equal(t, "value 'inputs' is variadic but is not the last value", err.Error())
+}
+
+func Test_Lint_OPTIONAL_BEFORE_REQUIRED(t *testing.T) {
+
+	_, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddValue(libclimate.Value("input"))
+		cl.AddValue(libclimate.Value("format").SetOptional())
+		cl.AddValue(libclimate.Value("output"))
+
+		return nil
+	})
+
+	require.NotNil(t, err)
+	require.Equal(t, "value 'output' is required but follows optional value 'format'", err.Error())
+
+	_, err = libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddValue(libclimate.Value("input"))
+		cl.AddValue(libclimate.Value("format").SetOptional())
+		cl.AddValue(libclimate.Value("others").SetOptional().SetVariadic())
+
+		return nil
+	})
+
+	require.Nil(t, err)
+}
+
+func Test_Lint_PANIC(t *testing.T) {
+
+	require.Panics(t, func() {
+
+		_, _ = libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+			cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+			cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+
+			return nil
+		}, libclimate.InitFlag_PanicOnFailure)
+	})
+}
@@ -0,0 +1,203 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"testing"
+)
+
+func Test_MacroAlias_1(t *testing.T) {
+
+	var calls []string
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlagFunc(clasp.Flag("--debug").SetHelp("Runs in debug mode"), func() {
+
+			calls = append(calls, "--debug")
+		})
+		cl.AddOptionFunc(clasp.Option("--verbosity").SetHelp("Specifies the verbosity").SetValues("terse", "chatty"), func(option *clasp.Argument, _ *clasp.Specification) {
+
+			calls = append(calls, "--verbosity="+option.Value)
+		})
+		cl.AddOptionFunc(clasp.Option("--color").SetHelp("Specifies the colour mode"), func(option *clasp.Argument, _ *clasp.Specification) {
+
+			calls = append(calls, "--color="+option.Value)
+		})
+		cl.AddMacroAlias("-C", "--verbosity=chatty", "--debug", "--color=always")
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r, _ := climate.ParseAndVerify([]string{"bin/myapp", "-C", "file.txt"}, stm, exiter)
+
+	require.Equal(t, []string{"--verbosity=chatty", "--debug", "--color=always"}, calls)
+	require.Equal(t, 3, len(r.Flags)+len(r.Options))
+	require.Equal(t, 1, len(r.Values))
+	require.Equal(t, "file.txt", r.Values[0].Value)
+	require.Equal(t, []string{"bin/myapp", "-C", "file.txt"}, r.Argv)
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+}
+
+func Test_MacroAlias_AFTER_DoubleDash(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+		cl.AddMacroAlias("-D", "--debug")
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r, _ := climate.Parse([]string{"bin/myapp", "--", "-D"}, stm, exiter)
+
+	require.Equal(t, 0, len(r.Flags))
+	require.Equal(t, 1, len(r.Values))
+	require.Equal(t, "-D", r.Values[0].Value)
+}
+
+func Test_MacroAlias_AS_OptionValue(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+		cl.AddOption(clasp.Option("--name").SetHelp("Specifies the name"))
+		cl.AddMacroAlias("-D", "--debug")
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r, _ := climate.Parse([]string{"bin/myapp", "--name", "-D"}, stm, exiter)
+
+	require.False(t, r.FlagIsSpecified("--debug"))
+
+	opt, found := r.LookupOption("--name")
+
+	require.True(t, found)
+	require.Equal(t, "-D", opt.Value)
+}
+
+func Test_MacroAlias_AFTER_OptionWithValue(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+		cl.AddOption(clasp.Option("--name").SetHelp("Specifies the name"))
+		cl.AddMacroAlias("-D", "--debug")
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r, _ := climate.Parse([]string{"bin/myapp", "--name=x", "-D"}, stm, exiter)
+
+	require.True(t, r.FlagIsSpecified("--debug"))
+
+	opt, found := r.LookupOption("--name")
+
+	require.True(t, found)
+	require.Equal(t, "x", opt.Value)
+}
+
+func Test_MacroAlias_AFTER_OptionValue(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+		cl.AddOption(clasp.Option("--name").SetHelp("Specifies the name"))
+		cl.AddMacroAlias("-D", "--debug")
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	// the second "--name" is the value of the first, so "-D" is expanded
+
+	r, _ := climate.Parse([]string{"bin/myapp", "--name", "--name", "-D"}, stm, exiter)
+
+	require.True(t, r.FlagIsSpecified("--debug"))
+
+	opt, found := r.LookupOption("--name")
+
+	require.True(t, found)
+	require.Equal(t, "--name", opt.Value)
+}
+
+func Test_MacroAlias_AS_OptionValue_OF_Alias(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+		cl.AddOption(clasp.Option("--name").SetAlias("-n").SetHelp("Specifies the name"))
+		cl.AddAlias("--name", "-N")
+		cl.AddMacroAlias("-D", "--debug")
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	for _, name := range []string{"-n", "-N"} {
+
+		stm := new(bytes.Buffer)
+		exiter := &internal.CaptureExiter{ExitCode: -1}
+
+		r, _ := climate.Parse([]string{"bin/myapp", name, "-D"}, stm, exiter)
+
+		require.False(t, r.FlagIsSpecified("--debug"))
+
+		opt, found := r.LookupOption("--name")
+
+		require.True(t, found)
+		require.Equal(t, "-D", opt.Value)
+	}
+}
+
+func Test_MacroAlias_ShowUsage(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+		cl.AddOption(clasp.Option("--verbosity").SetHelp("Specifies the verbosity"))
+		cl.AddMacroAlias("-C", "--verbosity=chatty", "--debug")
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	lines := usage_lines_(t, climate)
+
+	expected := []string{
+
+		"USAGE: myapp [ ... flags and options ... ]",
+		"flags/options:",
+		"\t--help",
+		"\t\tShows this help and exits",
+		"\t--version",
+		"\t\tShows version information and exits",
+		"\t--debug",
+		"\t\tRuns in debug mode",
+		"\t--verbosity=<value>",
+		"\t\tSpecifies the verbosity",
+		"\t-C --verbosity=chatty --debug",
+	}
+
+	require.Equal(t, expected, lines)
+}
@@ -0,0 +1,185 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"testing"
+)
+
+func negatable_climate_(t *testing.T) *libclimate.Climate {
+
+	climate, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.UsageHelpSuffix = ""
+
+		cl.AddFlag(clasp.Flag("--color").SetHelp("Uses colour"), libclimate.AliasFlag_Negatable)
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	require.Nil(t, err)
+
+	return climate
+}
+
+func Test_Negatable_States(t *testing.T) {
+
+	climate := negatable_climate_(t)
+
+	tests := []struct {
+		argv     []string
+		expected libclimate.FlagState
+	}{
+		{[]string{"bin/myapp"}, libclimate.FlagState_Unspecified},
+		{[]string{"bin/myapp", "--color"}, libclimate.FlagState_Set},
+		{[]string{"bin/myapp", "--no-color"}, libclimate.FlagState_Unset},
+		{[]string{"bin/myapp", "--color", "--no-color"}, libclimate.FlagState_Unset},
+		{[]string{"bin/myapp", "--no-color", "--color"}, libclimate.FlagState_Set},
+		{[]string{"bin/myapp", "--color", "--no-color", "--color"}, libclimate.FlagState_Set},
+	}
+
+	for _, test := range tests {
+
+		stm := new(bytes.Buffer)
+		exiter := &internal.CaptureExiter{ExitCode: -1}
+
+		r, _ := climate.Parse(test.argv, stm, exiter)
+
+		require.Equal(t, test.expected, r.LookupFlagState("--color"), "argv=%v", test.argv)
+
+		r.Verify()
+
+		require.Equal(t, "", stm.String(), "argv=%v", test.argv)
+		require.Equal(t, -1, exiter.ExitCode, "argv=%v", test.argv)
+	}
+}
+
+func Test_Negatable_NOT_Negatable(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.UsageHelpSuffix = ""
+
+		cl.AddFlag(clasp.Flag("--color").SetHelp("Uses colour"))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--no-color"}, stm, exiter)
+
+	require.Equal(t, "myapp: unrecognised flag/option: --no-color\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_Negatable_ShowUsage(t *testing.T) {
+
+	lines := usage_lines_(t, negatable_climate_(t))
+
+	expected := []string{
+
+		"USAGE: myapp [ ... flags and options ... ]",
+		"flags/options:",
+		"\t--help",
+		"\t\tShows this help and exits",
+		"\t--version",
+		"\t\tShows version information and exits",
+		"\t--color",
+		"\t\tUses colour",
+		"\t--no-color",
+		"\t\tNegates --color",
+	}
+
+	require.Equal(t, expected, lines)
+}
+
+func Test_Negatable_FLAG_FUNC(t *testing.T) {
+
+	var color bool
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlagFunc(clasp.Flag("--color").SetHelp("Uses colour"), func() {
+
+			color = true
+		}, libclimate.AliasFlag_Negatable)
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	tests := []struct {
+		argv     []string
+		color    bool
+		expected libclimate.FlagState
+	}{
+		{[]string{"bin/myapp", "--no-color"}, false, libclimate.FlagState_Unset},
+		{[]string{"bin/myapp", "--color"}, true, libclimate.FlagState_Set},
+		{[]string{"bin/myapp", "--color", "--no-color"}, true, libclimate.FlagState_Unset},
+	}
+
+	for _, test := range tests {
+
+		color = false
+
+		stm := new(bytes.Buffer)
+		exiter := &internal.CaptureExiter{ExitCode: -1}
+
+		r, _ := climate.ParseAndVerify(test.argv, stm, exiter)
+
+		// the flag function is invoked only for the flag, not its negation
+
+		require.Equal(t, test.color, color, "argv=%v", test.argv)
+		require.Equal(t, test.expected, r.LookupFlagState("--color"), "argv=%v", test.argv)
+		require.Equal(t, "", stm.String(), "argv=%v", test.argv)
+		require.Equal(t, -1, exiter.ExitCode, "argv=%v", test.argv)
+	}
+}
+
+func Test_Negatable_DEPRECATED(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+
+		cl.AddFlag(clasp.Flag("--color").SetHelp("Uses colour"), libclimate.AliasFlag_Negatable, libclimate.AliasFlag_Deprecated)
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	lines := usage_lines_(t, climate)
+
+	require.Contains(t, lines, "\t\tNegates --color (deprecated)")
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--no-color"}, stm, exiter)
+
+	require.Equal(t, "myapp: warning: --no-color is deprecated\n", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+}
+
+func Test_Negatable_HIDDEN(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.UsageHelpSuffix = ""
+
+		cl.AddFlag(clasp.Flag("--color").SetHelp("Uses colour"), libclimate.AliasFlag_Negatable, libclimate.AliasFlag_Hidden)
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	lines := usage_lines_(t, climate)
+
+	require.NotContains(t, lines, "\t--color")
+	require.NotContains(t, lines, "\t--no-color")
+}
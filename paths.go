@@ -0,0 +1,239 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * types
+ */
+
+// Flags that qualify a path-valued option, as specified to [PathOption],
+// or value, as specified to [ValueSpecification.SetPath].
+type PathFlag int64
+
+const (
+	PathFlag_None PathFlag = 0 // No path flags specified, other than that the option/value is a path.
+)
+
+const (
+	PathFlag_MustExist       PathFlag = 1 << iota // The path must exist.
+	PathFlag_MustBeFile                           // The path must exist and be a (regular) file.
+	PathFlag_MustBeDirectory                      // The path must exist and be a directory.
+	PathFlag_MustBeReadable                       // The path must exist and be readable.
+	PathFlag_MustBeWritable                       // The path must be writable, or, if it does not exist, its directory must be writable.
+	PathFlag_MustNotExist                         // The path must not exist.
+	PathFlag_AllowStdStream                       // The path may be "-", meaning the standard input/output stream, to which no other checks apply.
+	PathFlag_NoExpansion                          // Suppresses the expansion of a leading "~" and of environment variables (as in "$HOME" and "${HOME}").
+)
+
+const (
+	_libCLImate_Path = "_libCLImate_Path_F73BB1C0_92D7_4cd5_9C36_DB672290CBE7"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Obtains the path flags of the (path-valued) specification with the given
+// name, or false if there is none.
+func path_flags_of_(name string, specs []*clasp.Specification) (PathFlag, bool) {
+
+	for _, spec := range specs {
+
+		if spec.Name == name {
+
+			if flags, ok := spec.Extras[_libCLImate_Path].(PathFlag); ok {
+
+				return flags, true
+			}
+		}
+	}
+
+	return PathFlag_None, false
+}
+
+// Expands a leading "~" and any environment variables in the given path,
+// unless qualified by PathFlag_NoExpansion.
+func expand_path_(path string, flags PathFlag) string {
+
+	if 0 != (PathFlag_NoExpansion & flags) {
+
+		return path
+	}
+
+	if 0 != (PathFlag_AllowStdStream&flags) && "-" == path {
+
+		return path
+	}
+
+	path = os.ExpandEnv(path)
+
+	if "~" == path || strings.HasPrefix(path, "~/") || strings.HasPrefix(path, "~"+string(filepath.Separator)) {
+
+		if home, err := os.UserHomeDir(); err == nil {
+
+			path = home + path[1:]
+		}
+	}
+
+	return path
+}
+
+// Determines whether the given path, which is an existing file or
+// directory if fi is not nil, or otherwise does not exist, is writable,
+// i.e. whether it may be written or, if it does not exist, whether its
+// parent directory may be written; this does not modify the file system.
+func path_is_writable_(path string, fi fs.FileInfo) bool {
+
+	if fi == nil {
+
+		path = filepath.Dir(path)
+	}
+
+	return path_access_writable_(path)
+}
+
+// Checks the given path according to the given flags, returning an
+// [*InvalidValueError] if it does not satisfy them.
+func check_path_(name, path string, flags PathFlag) error {
+
+	if 0 != (PathFlag_AllowStdStream&flags) && "-" == path {
+
+		return nil
+	}
+
+	invalid := func(reason string, e error) error {
+
+		return &InvalidValueError{
+
+			Name:   name,
+			Value:  path,
+			Reason: reason,
+			Err:    e,
+		}
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+
+		if !errors.Is(err, fs.ErrNotExist) {
+
+			return invalid("cannot be accessed", err)
+		}
+
+		fi = nil
+	}
+
+	if fi == nil {
+
+		if 0 != ((PathFlag_MustExist | PathFlag_MustBeFile | PathFlag_MustBeDirectory | PathFlag_MustBeReadable) & flags) {
+
+			return invalid("does not exist", err)
+		}
+	} else {
+
+		if 0 != (PathFlag_MustNotExist & flags) {
+
+			return invalid("already exists", nil)
+		}
+
+		if 0 != (PathFlag_MustBeFile&flags) && !fi.Mode().IsRegular() {
+
+			return invalid("is not a file", nil)
+		}
+
+		if 0 != (PathFlag_MustBeDirectory&flags) && !fi.IsDir() {
+
+			return invalid("is not a directory", nil)
+		}
+
+		if 0 != (PathFlag_MustBeReadable & flags) {
+
+			if f, e := os.Open(path); e != nil {
+
+				return invalid("is not readable", e)
+			} else {
+
+				f.Close()
+			}
+		}
+	}
+
+	if 0 != (PathFlag_MustBeWritable&flags) && !path_is_writable_(path, fi) {
+
+		return invalid("is not writable", nil)
+	}
+
+	return nil
+}
+
+// Verifies that the value of each path-valued option satisfies its path
+// flags.
+func (result Result) validatePaths(stream io.Writer) bool {
+
+	for _, option := range result.Options {
+
+		if flags, is_path := path_flags_of_(option.ResolvedName, result.specifications); is_path {
+
+			if err := check_path_(option.ResolvedName, option.Value, flags); err != nil {
+
+				fmt.Fprintf(stream, "%s %v%s\n", result.prefix_(stream), err, uhs_(result.usageHelpSuffix))
+
+				result.exiter.Exit(1)
+
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Qualifies the given option specification as path-valued, returning the
+// qualified specification, which may then be passed to
+// [Climate.AddOption] (or [OptionVar]), as in:
+//
+//	cl.AddOption(libclimate.PathOption(clasp.Option("--input-file").SetHelp("Specifies the input file"), libclimate.PathFlag_MustBeFile))
+//
+// The option value has any leading "~" and environment variables expanded
+// by [Climate.Parse] (unless PathFlag_NoExpansion is specified), and is
+// checked according to flags by [Result.Verify].
+func PathOption(option clasp.Specification, flags PathFlag) clasp.Specification {
+
+	return option.SetExtra(_libCLImate_Path, flags)
+}
+
+// Qualifies the value as a path, which has any leading "~" and environment
+// variables expanded by [Climate.Parse] (unless PathFlag_NoExpansion is
+// specified), and is checked according to flags by [Result.Verify].
+func (spec ValueSpecification) SetPath(flags PathFlag) ValueSpecification {
+
+	spec.IsPath = true
+	spec.PathFlags = flags
+
+	return spec
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
@@ -0,0 +1,224 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func paths_climate_(t *testing.T, flags libclimate.PathFlag) *libclimate.Climate {
+
+	climate, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddOption(libclimate.PathOption(clasp.Option("--input-file").SetHelp("Specifies the input file"), flags))
+		cl.AddAlias("--input-file", "-i")
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	require.Nil(t, err)
+
+	return climate
+}
+
+// Parses, looks up the input-file option, and then verifies.
+func parse_input_file_(climate *libclimate.Climate, argv []string, options ...any) (r libclimate.Result, opt *clasp.Argument, found bool) {
+
+	r, _ = climate.Parse(argv, options...)
+
+	opt, found = r.LookupOption("--input-file")
+
+	r.Verify(options...)
+
+	return
+}
+
+func Test_PathOption_EXISTS(t *testing.T) {
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "in.txt")
+
+	require.Nil(t, os.WriteFile(path, []byte("abc"), 0644))
+
+	climate := paths_climate_(t, libclimate.PathFlag_MustBeFile|libclimate.PathFlag_MustBeReadable)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, opt, found := parse_input_file_(climate, []string{"bin/myapp", "-i", path}, stm, exiter)
+
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+
+	require.True(t, found)
+	require.Equal(t, path, opt.Value)
+}
+
+func Test_PathOption_DOES_NOT_EXIST(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "missing.txt")
+
+	climate := paths_climate_(t, libclimate.PathFlag_MustExist)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _, _ = parse_input_file_(climate, []string{"bin/myapp", "--input-file=" + path}, stm, exiter)
+
+	require.Equal(t, "myapp: --input-file '"+path+"' does not exist; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_PathOption_NOT_A_FILE(t *testing.T) {
+
+	dir := t.TempDir()
+
+	climate := paths_climate_(t, libclimate.PathFlag_MustBeFile)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _, _ = parse_input_file_(climate, []string{"bin/myapp", "--input-file=" + dir}, stm, exiter)
+
+	require.Equal(t, "myapp: --input-file '"+dir+"' is not a file; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_PathOption_MUST_NOT_EXIST(t *testing.T) {
+
+	dir := t.TempDir()
+
+	climate := paths_climate_(t, libclimate.PathFlag_MustNotExist)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _, _ = parse_input_file_(climate, []string{"bin/myapp", "--input-file=" + dir}, stm, exiter)
+
+	require.Equal(t, "myapp: --input-file '"+dir+"' already exists; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_PathOption_EXPANSION(t *testing.T) {
+
+	dir := t.TempDir()
+
+	t.Setenv("LIBCLIMATE_TEST_DIR", dir)
+
+	climate := paths_climate_(t, libclimate.PathFlag_MustBeDirectory)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, opt, _ := parse_input_file_(climate, []string{"bin/myapp", "--input-file=$LIBCLIMATE_TEST_DIR"}, stm, exiter)
+
+	require.Equal(t, "", stm.String())
+
+	require.Equal(t, dir, opt.Value)
+
+	if home, err := os.UserHomeDir(); err == nil {
+
+		r, _ := climate.Parse([]string{"bin/myapp", "--input-file=~/abc"}, stm, exiter)
+
+		opt, _ = r.LookupOption("--input-file")
+
+		require.Equal(t, home+"/abc", opt.Value)
+	}
+}
+
+func Test_PathOption_STD_STREAM(t *testing.T) {
+
+	climate := paths_climate_(t, libclimate.PathFlag_MustBeFile|libclimate.PathFlag_AllowStdStream)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, opt, _ := parse_input_file_(climate, []string{"bin/myapp", "--input-file=-"}, stm, exiter)
+
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+
+	require.Equal(t, "-", opt.Value)
+}
+
+func Test_PathOption_WRITABLE(t *testing.T) {
+
+	dir := t.TempDir()
+
+	climate := paths_climate_(t, libclimate.PathFlag_MustBeWritable)
+
+	for _, path := range []string{dir, filepath.Join(dir, "out.txt")} {
+
+		stm := new(bytes.Buffer)
+		exiter := &internal.CaptureExiter{ExitCode: -1}
+
+		_, _, _ = parse_input_file_(climate, []string{"bin/myapp", "--input-file=" + path}, stm, exiter)
+
+		require.Equal(t, "", stm.String())
+		require.Equal(t, -1, exiter.ExitCode)
+	}
+
+	// the check does not create (even temporarily) any file
+
+	entries, err := os.ReadDir(dir)
+
+	require.Nil(t, err)
+	require.Equal(t, 0, len(entries))
+
+	path := filepath.Join(dir, "missing", "out.txt")
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _, _ = parse_input_file_(climate, []string{"bin/myapp", "--input-file=" + path}, stm, exiter)
+
+	require.Equal(t, "myapp: --input-file '"+path+"' is not writable; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_PathValue_DOES_NOT_EXIST(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "missing.txt")
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddValue(libclimate.Value("input-file").SetPath(libclimate.PathFlag_MustExist))
+		cl.AddValue(libclimate.Value("output-dir").SetPath(libclimate.PathFlag_MustBeDirectory | libclimate.PathFlag_MustBeWritable).SetOptional())
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", path}, stm, exiter)
+
+	require.Equal(t, "myapp: input-file '"+path+"' does not exist; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+
+	dir := t.TempDir()
+	path = filepath.Join(dir, "in.txt")
+
+	require.Nil(t, os.WriteFile(path, []byte("abc"), 0644))
+
+	stm.Reset()
+	exiter.ExitCode = -1
+
+	r, _ := climate.ParseAndVerify([]string{"bin/myapp", path, dir}, stm, exiter)
+
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+
+	output, found := r.Value("output-dir")
+
+	require.True(t, found)
+	require.Equal(t, dir, output.Value)
+}
@@ -0,0 +1,181 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"text/template"
+	"time"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Obtains the build date, in the form "YYYY-MM-DD", from the VCS time in
+// the build information, or the empty string if it is not available.
+func build_date_() string {
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+
+		if _, t, _ := build_info_vcs_(bi); 10 <= len(t) {
+
+			return t[:10]
+		}
+	}
+
+	return ""
+}
+
+// Obtains the copyright year range, e.g. "2019-2026", from the given start
+// year to the current year.
+func copyright_years_(startYear int) string {
+
+	year := time.Now().Year()
+
+	if 0 == startYear || startYear >= year {
+
+		return fmt.Sprint(year)
+	}
+
+	return fmt.Sprintf("%d-%d", startYear, year)
+}
+
+// Obtains the placeholder values, including any custom placeholders.
+func (cl Climate) placeholder_values_(programName string) map[string]any {
+
+	values := map[string]any{
+
+		"ProgramName": programName,
+		"Version":     version_string_(cl.Version, cl.VersionPrefix),
+		"Copyright":   copyright_years_(cl.CopyrightStartYear),
+		"BuildDate":   build_date_(),
+		"Author":      cl.Author,
+		"Homepage":    cl.Homepage,
+	}
+
+	for name, value := range cl.placeholders {
+
+		if fn, ok := value.(func() string); ok {
+
+			values[name] = fn()
+		} else {
+
+			values[name] = value
+		}
+	}
+
+	return values
+}
+
+// Expands any placeholders in the given string, failing if it is not a
+// valid template or if it refers to an unknown placeholder.
+func (cl Climate) try_expand_placeholders_(s string, programName string) (string, error) {
+
+	if !strings.Contains(s, "{{") {
+
+		return s, nil
+	}
+
+	t, err := template.New("").Option("missingkey=error").Parse(s)
+	if err != nil {
+
+		return s, err
+	}
+
+	var sb strings.Builder
+
+	if err = t.Execute(&sb, cl.placeholder_values_(programName)); err != nil {
+
+		return s, err
+	}
+
+	return sb.String(), nil
+}
+
+// Expands any placeholders in the given string, which is returned
+// unchanged if it contains none, if it is not a valid template, or if it
+// refers to an unknown placeholder. (The templates are validated by
+// [Init], so the latter two arise only if they are changed thereafter.)
+func (cl Climate) expand_placeholders_(s string, programName string) string {
+
+	r, _ := cl.try_expand_placeholders_(s, programName)
+
+	return r
+}
+
+// Expands any placeholders in each of the given lines.
+func (cl Climate) expand_placeholder_lines_(lines []string, programName string) (r []string) {
+
+	for _, line := range lines {
+
+		r = append(r, cl.expand_placeholders_(line, programName))
+	}
+
+	return
+}
+
+// Obtains the examples, with any placeholders in their commands and
+// descriptions expanded.
+func (cl Climate) expand_examples_(programName string) (r []UsageExample) {
+
+	for _, example := range cl.Examples {
+
+		r = append(r, UsageExample{
+
+			Command:     cl.expand_placeholders_(example.Command, programName),
+			Description: cl.expand_placeholders_(example.Description, programName),
+		})
+	}
+
+	return
+}
+
+// Obtains the help topics, with any placeholders in their summaries and
+// lines expanded.
+func (cl Climate) expand_help_topics_(programName string) (r []HelpTopic) {
+
+	for _, topic := range cl.HelpTopics {
+
+		r = append(r, HelpTopic{
+
+			Name:    topic.Name,
+			Summary: cl.expand_placeholders_(topic.Summary, programName),
+			Lines:   cl.expand_placeholder_lines_(topic.Lines, programName),
+		})
+	}
+
+	return
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Adds a custom placeholder, which may be used, as "{{.<name>}}", in
+// [Climate.InfoLines], [Climate.UsageHelpSuffix], [Climate.Examples],
+// [Climate.Epilog], and [Climate.HelpTopics] (along with the standard
+// placeholders "{{.ProgramName}}", "{{.Version}}", "{{.Copyright}}",
+// "{{.BuildDate}}", "{{.Author}}", and "{{.Homepage}}"). The value may be a
+// string, a func() string (which is invoked whenever the placeholder is
+// expanded), or any other type (which is formatted as by [fmt.Sprint]).
+func (cl *Climate) AddPlaceholder(name string, value any) {
+
+	if cl.placeholders == nil {
+
+		cl.placeholders = make(map[string]any)
+	}
+
+	cl.placeholders[name] = value
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
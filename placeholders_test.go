@@ -0,0 +1,93 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func Test_Placeholders_INFO_LINES(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.Version = "0.1.2"
+		cl.VersionPrefix = "v"
+		cl.Author = "Matthew Wilson"
+		cl.Homepage = "https://github.com/synesissoftware/libCLImate.Go"
+		cl.CopyrightStartYear = 2019
+
+		cl.AddPlaceholder("Tool", "widget-suite")
+		cl.AddPlaceholder("Motto", func() string { return "just works" })
+
+		cl.InfoLines = []string{
+			"{{.ProgramName}} {{.Version}} - part of {{.Tool}}, which {{.Motto}}",
+			"Copyright (c) {{.Copyright}} {{.Author}}",
+			"{{.Homepage}}",
+			":version:",
+		}
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	lines := usage_lines_(t, climate)
+
+	require.Equal(t, "myapp v0.1.2 - part of widget-suite, which just works", lines[0])
+	require.Equal(t, fmt.Sprintf("Copyright (c) 2019-%d Matthew Wilson", time.Now().Year()), lines[1])
+	require.Equal(t, "https://github.com/synesissoftware/libCLImate.Go", lines[2])
+	require.Equal(t, "myapp v0.1.2", lines[3])
+}
+
+func Test_Placeholders_INVALID(t *testing.T) {
+
+	_, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.InfoLines = []string{
+
+			"{{.ProgramName}} by {{.Autor}}",
+		}
+		cl.UsageHelpSuffix = "; use '{{ .ProgramName --help' for usage"
+
+		cl.AddExample("{{.ProgramName}} in.txt", "Processes in.txt")
+		cl.AddHelpTopic("config", "Describes {{.Config}}")
+
+		return nil
+	})
+
+	require.NotNil(t, err)
+	require.ErrorContains(t, err, "info line 0 '{{.ProgramName}} by {{.Autor}}' is not a valid template")
+	require.ErrorContains(t, err, "usage-help suffix '; use '{{ .ProgramName --help' for usage' is not a valid template")
+	require.ErrorContains(t, err, "help topic 'config' summary 'Describes {{.Config}}' is not a valid template")
+	require.NotContains(t, err.Error(), "example")
+}
+
+func Test_Placeholders_USAGE_HELP_SUFFIX(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+		cl.UsageHelpSuffix = "; use '{{.ProgramName}} --help' for usage"
+		cl.ValuesConstraint = []int{1}
+		cl.ValueNames = []string{"input-file"}
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp"}, stm, exiter)
+
+	require.Equal(t, "myapp: input-file not specified; use 'myapp --help' for usage\n", stm.String())
+
+	stm.Reset()
+
+	climate.Abort("something failed", nil, stm, exiter)
+
+	require.Equal(t, "myapp: something failed; use 'myapp --help' for usage\n", stm.String())
+}
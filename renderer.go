@@ -0,0 +1,419 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"fmt"
+	"io"
+	"strings"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * types
+ */
+
+// A flag/option, or an alias, in the usage (see [UsageModel]), with its
+// qualifiers (see [AliasFlag]), default value, and environment variable
+// as fields, rather than as annotations of its help.
+type UsageSpecification struct {
+	Type        clasp.ArgType // The type, which is clasp.FlagType or clasp.OptionType (or, for an alias, clasp.FlagType).
+	Name        string        // The name, e.g. "--verbosity", or, for an alias, the names to which it resolves, e.g. "--verbosity=chatty".
+	Aliases     []string      // The aliases, e.g. "-v", or, for an alias, the alias itself, e.g. "-c".
+	Help        string        // The help, as specified, i.e. without any annotation such as "(required)".
+	Values      []string      // The values to which the option is restricted, if any.
+	Section     string        // The title of the section (see [Climate.Section]), or the empty string if it is in none.
+	IsAlias     bool          // Whether this is an alias (see [Climate.AddAlias] and [Climate.AddMacroAlias]), rather than a flag/option.
+	IsMacro     bool          // Whether this is a macro alias (see [Climate.AddMacroAlias]).
+	Expansion   []string      // For an alias, the flags/options, and/or options-with-values, to which it resolves, e.g. ["--verbosity=chatty"].
+	Required    bool          // Whether the flag/option is qualified by AliasFlag_Required.
+	Repeatable  bool          // Whether the flag/option is qualified by AliasFlag_Repeatable.
+	Single      bool          // Whether the flag/option is qualified by AliasFlag_Single.
+	Deprecated  bool          // Whether the flag/option is qualified by AliasFlag_Deprecated.
+	Negatable   bool          // Whether the flag is qualified by AliasFlag_Negatable.
+	Default     string        // The default value of the option, if any.
+	Environment string        // The name of the environment variable of the option (see [EnvironmentOption]), if any.
+
+	AliasSpecifications []UsageSpecification // For a flag/option, the aliases (other than macro aliases) that resolve to it, e.g. that of "-c" for "--verbosity=chatty", which are shown alongside it rather than separately.
+}
+
+// A section of the flags/options in the usage (see [Climate.Section]).
+type UsageSection struct {
+	Title          string               // The title of the section, which is empty for the flags/options that are in no section.
+	Specifications []UsageSpecification // The flags/options of the section, and those aliases that are not shown alongside a flag/option (see [UsageSpecification.AliasSpecifications]), such as macro aliases.
+}
+
+// Structured model of the CLI, from which the usage and the version are
+// rendered by a [UsageRenderer].
+//
+// Hidden flags/options (and their aliases) are omitted.
+type UsageModel struct {
+	ProgramName  string                // The program name.
+	Version      string                // The version, including any prefix, e.g. "v0.1.2".
+	InfoLines    []string              // The information lines, with placeholders expanded, and ":version:" replaced by the program name and version.
+	ValuesString string                // The values-string, e.g. "<input-file> [ <count> ]".
+	Sections     []UsageSection        // The flags/options, and aliases, by section, with those that are in no section first.
+	Values       []ValueSpecification  // The value specifications (see [Climate.AddValue]).
+	Examples     []UsageExample        // The examples, with placeholders expanded.
+	Environment  []EnvironmentVariable // The environment variables.
+	ExitStatuses []ExitStatus          // The exit statuses.
+	SeeAlso      []string              // The "see also" references.
+	Epilog       []string              // The epilog lines, with placeholders expanded.
+	HelpTopics   []HelpTopic           // The help topics, with placeholders expanded.
+	Width        int                   // The width to which descriptions should be wrapped, or UsageWidth_NoWrap (see [Climate.UsageWidth]).
+	Color        bool                  // Whether the output should be coloured (see [Climate.Color]).
+}
+
+// Interface for rendering the usage (for "--help"), the version (for
+// "--version"), and help topics (for "--help=<topic>"), which may be
+// specified as [Climate.UsageRenderer] to render them in a form other than
+// the default, [TextUsageRenderer].
+type UsageRenderer interface {
+	// Renders the usage to the given writer. This is also used for the help
+	// of a single flag/option (as in "--help=verbosity"), in which case the
+	// model comprises only that flag/option, any aliases that resolve to it,
+	// and any examples that use it.
+	RenderUsage(w io.Writer, model UsageModel) error
+	// Renders the version to the given writer.
+	RenderVersion(w io.Writer, model UsageModel) error
+	// Renders the given help topic to the given writer.
+	RenderHelpTopic(w io.Writer, model UsageModel, topic HelpTopic) error
+}
+
+// The default [UsageRenderer], which renders the usage and version in the
+// form of that of [clasp.ShowUsage] and [clasp.ShowVersion].
+type TextUsageRenderer struct {
+}
+
+// A writer that records the first error of the writer that it wraps,
+// after which it writes nothing, so that a renderer need check only once.
+type error_writer_ struct {
+	w   io.Writer
+	err error
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+func (ew *error_writer_) Write(p []byte) (int, error) {
+
+	if ew.err != nil {
+
+		return 0, ew.err
+	}
+
+	n, err := ew.w.Write(p)
+
+	if err != nil {
+
+		ew.err = err
+	}
+
+	return n, err
+}
+
+// Obtains the usage form of the given specifications, omitting any that
+// are hidden (and any aliases that resolve to them).
+func usage_specifications_(input []*clasp.Specification) (result []UsageSpecification) {
+
+	hidden := make(map[string]bool)
+
+	for _, spec := range input {
+
+		if 0 != (AliasFlag_Hidden & alias_flags_of_(spec)) {
+
+			hidden[spec.Name] = true
+		}
+	}
+
+	result = make([]UsageSpecification, 0, len(input))
+
+	for _, spec := range input {
+
+		aliasFlags := alias_flags_of_(spec)
+
+		if 0 != (AliasFlag_Hidden & aliasFlags) {
+
+			continue
+		}
+
+		is_alias := is_alias_specification_(spec)
+
+		// also omit any alias whose resolved name is hidden
+		if name, _, _ := strings.Cut(spec.Name, "="); is_alias && hidden[name] {
+
+			continue
+		}
+
+		us := UsageSpecification{
+
+			Type:       spec.Type,
+			Name:       spec.Name,
+			Aliases:    append([]string(nil), spec.Aliases...),
+			Help:       spec.Help,
+			Values:     append([]string(nil), spec.ValueSet...),
+			Section:    section_of_(spec),
+			IsAlias:    is_alias,
+			Required:   0 != (AliasFlag_Required & aliasFlags),
+			Repeatable: 0 != (AliasFlag_Repeatable & aliasFlags),
+			Single:     0 != (AliasFlag_Single & aliasFlags),
+			Deprecated: 0 != (AliasFlag_Deprecated & aliasFlags),
+			Negatable:  0 != (AliasFlag_Negatable & aliasFlags),
+		}
+
+		if is_alias {
+
+			_, us.IsMacro = spec.Extras[_libCLImate_MacroAlias]
+			us.Expansion = append([]string(nil), alias_resolved_names_(spec)...)
+		}
+
+		if clasp.OptionType == spec.Type {
+
+			us.Default = spec.DefaultValue
+		}
+
+		us.Environment, _ = environment_of_(spec)
+
+		result = append(result, us)
+	}
+
+	return
+}
+
+// Obtains the given specifications with each alias (other than a macro
+// alias) that resolves to one of the flags/options placed alongside it -
+// in its AliasSpecifications - rather than separately.
+func group_aliases_(specs []UsageSpecification) (result []UsageSpecification) {
+
+	is_grouped := func(spec UsageSpecification, indexes map[string]int) bool {
+
+		if !spec.IsAlias || spec.IsMacro {
+
+			return false
+		}
+
+		name, _, _ := strings.Cut(spec.Name, "=")
+
+		_, exists := indexes[name]
+
+		return exists
+	}
+
+	indexes := make(map[string]int)
+
+	for _, spec := range specs {
+
+		if !spec.IsAlias {
+
+			indexes[spec.Name] = -1
+		}
+	}
+
+	for _, spec := range specs {
+
+		if is_grouped(spec, indexes) {
+
+			continue
+		}
+
+		if !spec.IsAlias {
+
+			indexes[spec.Name] = len(result)
+		}
+
+		result = append(result, spec)
+	}
+
+	for _, spec := range specs {
+
+		if is_grouped(spec, indexes) {
+
+			name, _, _ := strings.Cut(spec.Name, "=")
+
+			i := indexes[name]
+
+			result[i].AliasSpecifications = append(result[i].AliasSpecifications, spec)
+		}
+	}
+
+	return
+}
+
+// Obtains the help of the given specification as shown in the usage,
+// annotated with its qualifiers, environment variable, and default value,
+// as in "Specifies the input (required) (default: in.txt)".
+func usage_help_(spec UsageSpecification) string {
+
+	help := spec.Help
+
+	if spec.Deprecated {
+
+		help += " (deprecated)"
+	}
+	if spec.Required {
+
+		help += " (required)"
+	}
+	if spec.Repeatable {
+
+		help += " (may be specified more than once)"
+	}
+	if 0 != len(spec.Environment) {
+
+		help += fmt.Sprintf(" (environment: %s)", spec.Environment)
+	}
+	if 0 != len(spec.Default) {
+
+		help += fmt.Sprintf(" (default: %s)", spec.Default)
+	}
+
+	return help
+}
+
+// Obtains the usage parameters corresponding to the given model.
+func usage_params_of_(w io.Writer, model UsageModel) usage_params_ {
+
+	return usage_params_{
+
+		ProgramName:  model.ProgramName,
+		Version:      model.Version,
+		InfoLines:    model.InfoLines,
+		ValuesString: model.ValuesString,
+		Sections:     model.Sections,
+		Values:       model.Values,
+		Examples:     model.Examples,
+		Environment:  model.Environment,
+		ExitStatuses: model.ExitStatuses,
+		SeeAlso:      model.SeeAlso,
+		Epilog:       model.Epilog,
+		HelpTopics:   model.HelpTopics,
+		Width:        model.Width,
+		Style:        style_(model.Color),
+		Stream:       w,
+	}
+}
+
+// Obtains the usage renderer, which is [TextUsageRenderer] unless
+// [Climate.UsageRenderer] is specified.
+func (cl Climate) usage_renderer_() UsageRenderer {
+
+	if cl.UsageRenderer != nil {
+
+		return cl.UsageRenderer
+	}
+
+	return TextUsageRenderer{}
+}
+
+// Obtains the usage model, with the width and colouring determined
+// according to the given stream.
+func (cl Climate) usage_model_(programName string, stream io.Writer) UsageModel {
+
+	_, _, valuesString := cl.values_()
+
+	model := UsageModel{
+
+		ProgramName:  programName,
+		Version:      version_string_(cl.Version, cl.VersionPrefix),
+		ValuesString: valuesString,
+		Values:       value_specifications_(cl.ValueSpecifications),
+		Examples:     cl.expand_examples_(programName),
+		Environment:  cl.Environment,
+		ExitStatuses: cl.ExitStatuses,
+		SeeAlso:      cl.SeeAlso,
+		Epilog:       cl.expand_placeholder_lines_(cl.Epilog, programName),
+		HelpTopics:   cl.expand_help_topics_(programName),
+		Width:        usage_width_(cl.UsageWidth, stream),
+		Color:        color_is_enabled_(cl.color_mode_(), stream),
+	}
+
+	for _, line := range cl.expand_placeholder_lines_(cl.InfoLines, programName) {
+
+		if ":version:" == line {
+
+			line = programName + " " + model.Version
+		}
+
+		model.InfoLines = append(model.InfoLines, line)
+	}
+
+	specs := group_aliases_(usage_specifications_(cl.Specifications))
+
+	titles, groups := group_specifications_(specs)
+
+	for _, title := range titles {
+
+		section := UsageSection{
+
+			Title: title,
+		}
+
+		for _, i := range groups[title] {
+
+			section.Specifications = append(section.Specifications, specs[i])
+		}
+
+		if 0 != len(section.Specifications) {
+
+			model.Sections = append(model.Sections, section)
+		}
+	}
+
+	return model
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Renders the usage, in the form of that of [clasp.ShowUsage], failing
+// only if the writer fails.
+func (TextUsageRenderer) RenderUsage(w io.Writer, model UsageModel) error {
+
+	ew := &error_writer_{w: w}
+
+	show_usage_(usage_params_of_(ew, model))
+
+	return ew.err
+}
+
+// Renders the version, in the form of that of [clasp.ShowVersion],
+// failing only if the writer fails.
+func (TextUsageRenderer) RenderVersion(w io.Writer, model UsageModel) error {
+
+	ew := &error_writer_{w: w}
+
+	show_version_(usage_params_of_(ew, model))
+
+	return ew.err
+}
+
+// Renders the given help topic, comprising its name, summary, and lines,
+// failing only if the writer fails.
+func (TextUsageRenderer) RenderHelpTopic(w io.Writer, model UsageModel, topic HelpTopic) error {
+
+	ew := &error_writer_{w: w}
+
+	show_help_topic_(usage_params_of_(ew, model), topic)
+
+	return ew.err
+}
+
+// Obtains the model of the CLI from which the usage and version are
+// rendered, using [Climate.ProgramName], and determining the width and
+// colouring according to the given stream.
+func (cl Climate) UsageModel(stream io.Writer) UsageModel {
+
+	return cl.usage_model_(cl.ProgramName, stream)
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
@@ -0,0 +1,273 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+// A [libclimate.UsageRenderer] that renders in a (contrived) house style,
+// and records the model.
+type house_style_renderer struct {
+	model libclimate.UsageModel
+}
+
+func (r *house_style_renderer) RenderUsage(w io.Writer, model libclimate.UsageModel) error {
+
+	r.model = model
+
+	for _, section := range model.Sections {
+
+		for _, spec := range section.Specifications {
+
+			if spec.IsAlias {
+
+				fmt.Fprintf(w, "[%s] %s => %s\n", section.Title, strings.Join(spec.Aliases, " "), strings.Join(spec.Expansion, " "))
+
+				continue
+			}
+
+			fmt.Fprintf(w, "[%s] %s: %s", section.Title, spec.Name, spec.Help)
+
+			if spec.Required {
+
+				fmt.Fprint(w, " {required}")
+			}
+			if 0 != len(spec.Default) {
+
+				fmt.Fprintf(w, " {default=%s}", spec.Default)
+			}
+
+			fmt.Fprintln(w)
+
+			for _, alias := range spec.AliasSpecifications {
+
+				fmt.Fprintf(w, "[%s]   %s => %s\n", section.Title, strings.Join(alias.Aliases, " "), strings.Join(alias.Expansion, " "))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *house_style_renderer) RenderVersion(w io.Writer, model libclimate.UsageModel) error {
+
+	r.model = model
+
+	fmt.Fprintf(w, "%s version %s\n", model.ProgramName, model.Version)
+
+	return nil
+}
+
+func (r *house_style_renderer) RenderHelpTopic(w io.Writer, model libclimate.UsageModel, topic libclimate.HelpTopic) error {
+
+	r.model = model
+
+	fmt.Fprintf(w, "%s - %s\n", topic.Name, topic.Summary)
+
+	return nil
+}
+
+func renderer_climate_(renderer libclimate.UsageRenderer) *libclimate.Climate {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.Version = []int{1, 2, 3}
+		cl.InfoLines = []string{"My App", ":version:"}
+		cl.UsageRenderer = renderer
+		cl.UsageWidth = 60
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+		cl.AddFlag(clasp.Flag("--trace").SetHelp("Traces everything"), libclimate.AliasFlag_Hidden)
+
+		cl.Section("Output options")
+
+		cl.AddOption(clasp.Option("--format").SetHelp("Specifies the format").SetDefaultValue("text"))
+		cl.AddOption(clasp.Option("--output").SetHelp("Specifies the output"), libclimate.AliasFlag_Required)
+		cl.AddAlias("--format=json", "-j")
+
+		cl.AddValue(libclimate.Value("input-file"))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	return climate
+}
+
+func Test_UsageRenderer_CUSTOM_USAGE(t *testing.T) {
+
+	renderer := &house_style_renderer{}
+	climate := renderer_climate_(renderer)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.Parse([]string{"bin/myapp", "--help"}, stm, exiter)
+
+	require.Equal(t, 0, exiter.ExitCode)
+	require.Equal(t, `[] --help: Shows this help and exits
+[] --version: Shows version information and exits
+[] --debug: Runs in debug mode
+[Output options] --format: Specifies the format {default=text}
+[Output options]   -j => --format=json
+[Output options] --output: Specifies the output {required}
+`, stm.String())
+
+	model := renderer.model
+
+	require.Equal(t, "myapp", model.ProgramName)
+	require.Equal(t, "1.2.3", model.Version)
+	require.Equal(t, []string{"My App", "myapp 1.2.3"}, model.InfoLines)
+	require.Equal(t, "<input-file>", model.ValuesString)
+	require.Equal(t, 2, len(model.Sections))
+	require.Equal(t, "", model.Sections[0].Title)
+	require.Equal(t, "Output options", model.Sections[1].Title)
+	require.Equal(t, 1, len(model.Values))
+	require.Equal(t, 60, model.Width)
+	require.False(t, model.Color)
+
+	require.Equal(t, 2, len(model.Sections[1].Specifications))
+	require.Equal(t, 1, len(model.Sections[1].Specifications[0].AliasSpecifications))
+
+	alias := model.Sections[1].Specifications[0].AliasSpecifications[0]
+
+	require.True(t, alias.IsAlias)
+	require.False(t, alias.IsMacro)
+	require.Equal(t, []string{"-j"}, alias.Aliases)
+	require.Equal(t, []string{"--format=json"}, alias.Expansion)
+	require.Equal(t, "Output options", alias.Section)
+}
+
+func Test_UsageRenderer_CUSTOM_VERSION(t *testing.T) {
+
+	renderer := &house_style_renderer{}
+	climate := renderer_climate_(renderer)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.Parse([]string{"bin/myapp", "--version"}, stm, exiter)
+
+	require.Equal(t, 0, exiter.ExitCode)
+	require.Equal(t, "myapp version 1.2.3\n", stm.String())
+}
+
+func Test_UsageRenderer_TEXT(t *testing.T) {
+
+	var texts []string
+
+	for _, renderer := range []libclimate.UsageRenderer{nil, libclimate.TextUsageRenderer{}} {
+
+		climate := renderer_climate_(renderer)
+
+		stm := new(bytes.Buffer)
+
+		_, _ = climate.Parse([]string{"bin/myapp", "--help"}, stm, internal.StubExiter{})
+
+		texts = append(texts, stm.String())
+	}
+
+	require.Equal(t, texts[0], texts[1])
+	require.Contains(t, texts[0], "My App\nmyapp 1.2.3\nUSAGE: myapp [ ... flags and options ... ] <input-file>\n")
+
+	climate := renderer_climate_(nil)
+
+	climate.ProgramName = "myapp"
+
+	stm := new(bytes.Buffer)
+
+	require.Nil(t, libclimate.TextUsageRenderer{}.RenderUsage(stm, climate.UsageModel(stm)))
+	require.Equal(t, texts[0], stm.String())
+}
+
+// A [libclimate.UsageRenderer] that fails to render.
+type failing_renderer struct{}
+
+func (failing_renderer) RenderUsage(w io.Writer, model libclimate.UsageModel) error {
+
+	return errors.New("cannot render")
+}
+
+func (failing_renderer) RenderVersion(w io.Writer, model libclimate.UsageModel) error {
+
+	return errors.New("cannot render")
+}
+
+func (failing_renderer) RenderHelpTopic(w io.Writer, model libclimate.UsageModel, topic libclimate.HelpTopic) error {
+
+	return errors.New("cannot render")
+}
+
+func Test_UsageRenderer_FAILING(t *testing.T) {
+
+	climate := renderer_climate_(failing_renderer{})
+
+	climate.ProgramName = "myapp"
+	climate.UsageHelpSuffix = ""
+	climate.AddHelpTopic("config", "Describes the configuration file")
+
+	tests := []struct {
+		arg      string
+		expected string
+	}{
+		{"--help", "myapp: failed to render usage: cannot render\n"},
+		{"--help=config", "myapp: failed to render usage: cannot render\n"},
+		{"--help=debug", "myapp: failed to render usage: cannot render\n"},
+		{"--version", "myapp: failed to render version: cannot render\n"},
+	}
+
+	for _, test := range tests {
+
+		stdout := new(bytes.Buffer)
+		stderr := new(bytes.Buffer)
+		exiter := &internal.CaptureExiter{ExitCode: -1}
+
+		_, _ = climate.Parse([]string{"bin/myapp", test.arg}, libclimate.OutputStream{Writer: stdout}, libclimate.ErrorStream{Writer: stderr}, exiter)
+
+		require.Equal(t, 1, exiter.ExitCode, test.arg)
+		require.Equal(t, "", stdout.String(), test.arg)
+		require.Equal(t, test.expected, stderr.String(), test.arg)
+	}
+}
+
+// A writer that fails.
+type failing_writer struct{}
+
+func (failing_writer) Write(p []byte) (int, error) {
+
+	return 0, errors.New("disk full")
+}
+
+func Test_TextUsageRenderer_FAILING_WRITER(t *testing.T) {
+
+	climate := renderer_climate_(nil)
+
+	model := climate.UsageModel(io.Discard)
+
+	require.EqualError(t, libclimate.TextUsageRenderer{}.RenderUsage(failing_writer{}, model), "disk full")
+	require.EqualError(t, libclimate.TextUsageRenderer{}.RenderVersion(failing_writer{}, model), "disk full")
+	require.EqualError(t, libclimate.TextUsageRenderer{}.RenderHelpTopic(failing_writer{}, model, libclimate.HelpTopic{Name: "config"}), "disk full")
+	require.EqualError(t, libclimate.ManPageUsageRenderer{}.RenderUsage(failing_writer{}, model), "disk full")
+	require.EqualError(t, libclimate.MarkdownUsageRenderer{}.RenderUsage(failing_writer{}, model), "disk full")
+
+	climate.ProgramName = "myapp"
+	climate.UsageHelpSuffix = ""
+
+	stderr := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.Parse([]string{"bin/myapp", "--help"}, libclimate.OutputStream{Writer: failing_writer{}}, libclimate.ErrorStream{Writer: stderr}, exiter)
+
+	require.Equal(t, 1, exiter.ExitCode)
+	require.Equal(t, "myapp: failed to render usage: disk full\n", stderr.String())
+}
@@ -0,0 +1,114 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	clasp "github.com/synesissoftware/CLASP.Go"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * constants
+ */
+
+const (
+	_libCLImate_Section = "_libCLImate_Section_F73BB1C0_92D7_4cd5_9C36_DB672290CBE7"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Obtains the title of the section of the given specification, or the
+// empty string if it is in none.
+func section_of_(spec *clasp.Specification) string {
+
+	if title, ok := spec.Extras[_libCLImate_Section].(string); ok {
+
+		return title
+	}
+
+	return ""
+}
+
+// Adds the given specification, placing it in the current section (see
+// [Climate.Section]) unless it is already in one.
+func (cl *Climate) append_specification_(spec *clasp.Specification) {
+
+	if 0 != len(cl.section) {
+
+		if _, has_section := spec.Extras[_libCLImate_Section]; !has_section {
+
+			*spec = spec.SetExtra(_libCLImate_Section, cl.section)
+		}
+	}
+
+	cl.Specifications = append(cl.Specifications, spec)
+}
+
+// Groups the given specifications by section, returning the section
+// titles, in the order of their first appearance, and, for each, the
+// indexes of its specifications, in their original order. The
+// specifications that are in no section are first, under the title "".
+func group_specifications_(specs []UsageSpecification) (titles []string, groups map[string][]int) {
+
+	groups = make(map[string][]int)
+
+	titles = append(titles, "")
+
+	for i := range specs {
+
+		title := specs[i].Section
+
+		if _, exists := groups[title]; !exists && 0 != len(title) {
+
+			titles = append(titles, title)
+		}
+
+		groups[title] = append(groups[title], i)
+	}
+
+	return
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Begins a section, with the given title, in which all flags, options, and
+// aliases subsequently added are placed, and under which they are shown in
+// the usage, as in:
+//
+//	cl.Section("Output options")
+//
+//	cl.AddFlag(clasp.Flag("--json").SetHelp("Writes the output as JSON"))
+//	cl.AddOption(clasp.Option("--output-file").SetHelp("Specifies the output file"))
+//
+//	cl.Section("Network options")
+//
+//	cl.AddOption(clasp.Option("--timeout").SetHelp("Specifies the timeout"))
+//
+// Those that are in no section - which include "--help" and "--version" -
+// are shown first, followed by each section, in the order of its first
+// flag/option. An empty title ends the current section.
+func (cl *Climate) Section(title string) {
+
+	cl.section = title
+}
+
+// Places the given specification in the section with the given title,
+// regardless of the current section (see [Climate.Section]), returning the
+// qualified specification, which may then be passed to [Climate.AddFlag],
+// [Climate.AddOption] (or [FlagVar] or [OptionVar]), etc.
+func InSection(spec clasp.Specification, title string) clasp.Specification {
+
+	return spec.SetExtra(_libCLImate_Section, title)
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
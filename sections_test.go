@@ -0,0 +1,111 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func sections_climate_() *libclimate.Climate {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode"))
+
+		cl.Section("Output options")
+
+		cl.AddFlag(clasp.Flag("--json").SetHelp("Writes the output as JSON"), libclimate.AliasFlag_Negatable)
+		cl.AddOption(clasp.Option("--output-file").SetHelp("Specifies the output file"))
+		cl.AddAlias("--output-file", "-o")
+
+		cl.Section("Network options")
+
+		cl.AddOption(clasp.Option("--timeout").SetHelp("Specifies the timeout"))
+		cl.AddFlag(libclimate.InSection(clasp.Flag("--quiet").SetHelp("Suppresses output"), "Output options"))
+		cl.AddMacroAlias("-N", "--timeout=0")
+
+		cl.Section("")
+
+		cl.AddFlag(clasp.Flag("--trace").SetHelp("Traces everything"))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	return climate
+}
+
+func Test_Section_USAGE(t *testing.T) {
+
+	climate := sections_climate_()
+
+	lines := usage_lines_(t, climate)
+
+	require.Equal(t, []string{
+		"USAGE: myapp [ ... flags and options ... ]",
+		"flags/options:",
+		"\t--help",
+		"\t\tShows this help and exits",
+		"\t--version",
+		"\t\tShows version information and exits",
+		"\t--debug",
+		"\t\tRuns in debug mode",
+		"\t--trace",
+		"\t\tTraces everything",
+		"\tOutput options:",
+		"\t--json",
+		"\t\tWrites the output as JSON",
+		"\t--no-json",
+		"\t\tNegates --json",
+		"\t-o --output-file",
+		"\t--output-file=<value>",
+		"\t\tSpecifies the output file",
+		"\t--quiet",
+		"\t\tSuppresses output",
+		"\tNetwork options:",
+		"\t--timeout=<value>",
+		"\t\tSpecifies the timeout",
+		"\t-N --timeout=0",
+	}, lines)
+}
+
+func Test_Section_JSON(t *testing.T) {
+
+	climate := sections_climate_()
+
+	stm := new(bytes.Buffer)
+
+	require.Nil(t, climate.WriteHelpJSON(stm))
+
+	var doc struct {
+		Specifications []struct {
+			Name    string `json:"name"`
+			Section string `json:"section"`
+		} `json:"specifications"`
+		Sections []string `json:"sections"`
+	}
+
+	require.Nil(t, json.Unmarshal(stm.Bytes(), &doc))
+
+	require.Equal(t, []string{"Output options", "Network options"}, doc.Sections)
+
+	sections := make(map[string]string)
+
+	for _, spec := range doc.Specifications {
+
+		sections[spec.Name] = spec.Section
+	}
+
+	require.Equal(t, "", sections["--debug"])
+	require.Equal(t, "Output options", sections["--json"])
+	require.Equal(t, "Output options", sections["--no-json"])
+	require.Equal(t, "Output options", sections["--output-file"])
+	require.Equal(t, "Output options", sections["--quiet"])
+	require.Equal(t, "Network options", sections["--timeout"])
+	require.Equal(t, "", sections["--trace"])
+}
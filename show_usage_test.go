@@ -328,3 +328,80 @@ func Test_ShowUsage_3_NoVersionFlag(t *testing.T) {
 		)
 	}
 }
+
+// The usage rendered by TextUsageRenderer must be identical, line for
+// line, to that of clasp.ShowUsage() for the same specifications (and
+// the version identical to that of clasp.ShowVersion())
+func Test_ShowUsage_PARITY_WITH_CLASP(t *testing.T) {
+
+	climate, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.Version = []int{0, 1, 2}
+
+		cl.InfoLines = []string{
+
+			"ShowUsage tests",
+			":version:",
+			"",
+		}
+		cl.ValuesString = "<path-1> <path-2>"
+
+		cl.AddFlag(clasp.Flag("--debug").
+			SetAlias("-d").
+			SetHelp("Runs in debug mode"))
+		cl.AddOption(clasp.Option("--verbosity").
+			SetAlias("-v").
+			SetHelp("Specifies the verbosity").
+			SetValues("terse", "chatty"))
+		cl.AddAlias("--verbosity=chatty", "-c")
+
+		return nil
+	})
+	if err != nil {
+
+		fmt.Fprintf(os.Stderr, "failed to create CLI parser: %v\n", err)
+	}
+
+	var specs []clasp.Specification
+
+	for _, spec := range climate.Specifications {
+
+		specs = append(specs, *spec)
+	}
+
+	tests := []struct {
+		flag string
+		show func(specs []clasp.Specification, params clasp.UsageParams) (int, error)
+	}{
+		{"--help", clasp.ShowUsage},
+		{"--version", clasp.ShowVersion},
+	}
+
+	for _, test := range tests {
+
+		expected := new(bytes.Buffer)
+		actual := new(bytes.Buffer)
+
+		_, _ = test.show(specs, clasp.UsageParams{
+
+			Version:       climate.Version,
+			VersionPrefix: climate.VersionPrefix,
+			InfoLines:     climate.InfoLines,
+			ValuesString:  climate.ValuesString,
+			Stream:        expected,
+			Exiter:        internal.StubExiter{},
+			ProgramName:   "myapp",
+		})
+
+		_, _ = climate.Parse([]string{"bin/myapp", test.flag}, actual, internal.StubExiter{})
+
+		if expected.String() != actual.String() {
+
+			t.Errorf("%s: expected \n'%v'\n != actual \n'%v'",
+				test.flag,
+				lines_to_display_string_(strings.Split(expected.String(), "\n")),
+				lines_to_display_string_(strings.Split(actual.String(), "\n")),
+			)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"testing"
+)
+
+func Test_Streams_Version_TO_OutputStream(t *testing.T) {
+
+	out := new(bytes.Buffer)
+	errs := new(bytes.Buffer)
+	argv := []string{"bin/myapp", "--version"}
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.Version = "0.0.1"
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	_, _ = climate.ParseAndVerify(argv, libclimate.OutputStream{Writer: out}, libclimate.ErrorStream{Writer: errs}, internal.StubExiter{})
+
+	require.Equal(t, "myapp 0.0.1\n", out.String())
+	require.Equal(t, "", errs.String())
+}
+
+func Test_Streams_Error_TO_ErrorStream(t *testing.T) {
+
+	out := new(bytes.Buffer)
+	errs := new(bytes.Buffer)
+	argv := []string{"bin/myapp", "--unknown"}
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.UsageHelpSuffix = ""
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	_, _ = climate.ParseAndVerify(argv, libclimate.OutputStream{Writer: out}, libclimate.ErrorStream{Writer: errs}, internal.StubExiter{})
+
+	require.Equal(t, "", out.String())
+	require.Equal(t, "myapp: unrecognised flag/option: --unknown\n", errs.String())
+}
+
+func Test_Streams_SPECIFIED_TO_Init(t *testing.T) {
+
+	out := new(bytes.Buffer)
+	errs := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.Version = "0.0.1"
+		cl.UsageHelpSuffix = ""
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure, libclimate.OutputStream{Writer: out}, libclimate.ErrorStream{Writer: errs}, exiter)
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--version"})
+
+	require.Equal(t, "myapp 0.0.1\n", out.String())
+	require.Equal(t, "", errs.String())
+	require.Equal(t, 0, exiter.ExitCode)
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--unknown"})
+
+	require.Equal(t, "myapp 0.0.1\n", out.String())
+	require.Equal(t, "myapp: unrecognised flag/option: --unknown\n", errs.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_Streams_UNTYPED_Writer_OVERRIDDEN_BY_ErrorStream(t *testing.T) {
+
+	stm := new(bytes.Buffer)
+	errs := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+		cl.UsageHelpSuffix = ""
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure, stm, exiter)
+
+	climate.Abort("Some-failure-condition", nil, libclimate.ErrorStream{Writer: errs})
+
+	require.Equal(t, "", stm.String())
+	require.Equal(t, "myapp: Some-failure-condition\n", errs.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
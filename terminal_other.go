@@ -0,0 +1,26 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !(darwin || dragonfly || freebsd || linux || netbsd || openbsd)
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Obtains the width, in columns, of the terminal with the given file
+// descriptor, which, on this platform, is not supported, so always returns
+// false.
+func terminal_columns_(fd uintptr) (int, bool) {
+
+	return 0, false
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
@@ -0,0 +1,200 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"fmt"
+	"io"
+	"strings"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * types
+ */
+
+// Parameters to show_usage_(), show_version_(), and
+// show_version_verbose_().
+type usage_params_ struct {
+	ProgramName   string
+	Version       any
+	VersionPrefix string
+	InfoLines     []string
+	ValuesString  string
+	Sections      []UsageSection
+	Values        []ValueSpecification
+	Examples      []UsageExample
+	Environment   []EnvironmentVariable
+	ExitStatuses  []ExitStatus
+	SeeAlso       []string
+	Epilog        []string
+	HelpTopics    []HelpTopic
+	Width         int
+	Style         style_
+	Stream        io.Writer
+	Exiter        internal.Exiter
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Forms a version string from the given version, which may be a string, a
+// slice of numbers or strings, or any other type (that is formatted as by
+// [fmt.Sprint]), and the given prefix.
+func version_string_(version any, versionPrefix string) string {
+
+	var parts []string
+
+	switch v := version.(type) {
+
+	case nil:
+
+		return ""
+	case string:
+
+		return versionPrefix + v
+	case []string:
+
+		parts = v
+	case []int:
+
+		for _, n := range v {
+
+			parts = append(parts, fmt.Sprint(n))
+		}
+	case []uint16:
+
+		for _, n := range v {
+
+			parts = append(parts, fmt.Sprint(n))
+		}
+	case []any:
+
+		for _, n := range v {
+
+			parts = append(parts, fmt.Sprint(n))
+		}
+	default:
+
+		return versionPrefix + fmt.Sprint(v)
+	}
+
+	return versionPrefix + strings.Join(parts, ".")
+}
+
+// Indicates whether the given specification is an alias, i.e. one added
+// by [Climate.AddAlias] or [Climate.AddMacroAlias].
+func is_alias_specification_(spec *clasp.Specification) bool {
+
+	_, ok := spec.Extras[_libCLImate_Alias]
+
+	return ok
+}
+
+func show_usage_specification_(stream io.Writer, spec *UsageSpecification, width int, style style_) {
+
+	if spec.IsAlias {
+
+		fmt.Fprintf(stream, "\t%s %s\n", style.name_(strings.Join(spec.Aliases, " ")), spec.Name)
+		fmt.Fprintln(stream)
+
+		return
+	}
+
+	for _, alias := range spec.AliasSpecifications {
+
+		fmt.Fprintf(stream, "\t%s %s\n", style.name_(strings.Join(alias.Aliases, " ")), alias.Name)
+	}
+
+	if clasp.OptionType == spec.Type {
+
+		for _, alias := range spec.Aliases {
+
+			fmt.Fprintf(stream, "\t%s %s\n", style.name_(alias), style.placeholder_("<value>"))
+		}
+
+		fmt.Fprintf(stream, "\t%s=%s\n", style.name_(spec.Name), style.placeholder_("<value>"))
+	} else {
+
+		for _, alias := range spec.Aliases {
+
+			fmt.Fprintf(stream, "\t%s\n", style.name_(alias))
+		}
+
+		fmt.Fprintf(stream, "\t%s\n", style.name_(spec.Name))
+	}
+
+	show_usage_wrapped_(stream, usageHelpIndentation_, usage_help_(*spec), width)
+
+	if 0 != len(spec.Values) {
+
+		fmt.Fprintf(stream, "\t\twhere %s one of:\n", style.placeholder_("<value>"))
+
+		for _, value := range spec.Values {
+
+			fmt.Fprintf(stream, "\t\t\t%s\n", value)
+		}
+	}
+
+	fmt.Fprintln(stream)
+}
+
+// Writes the usage, in the form of that of [clasp.ShowUsage].
+func show_usage_(params usage_params_) {
+
+	stream := params.Stream
+
+	for _, line := range params.InfoLines {
+
+		fmt.Fprintln(stream, line)
+	}
+
+	if 0 != len(params.ValuesString) {
+
+		fmt.Fprintf(stream, "%s %s [ ... flags and options ... ] %s\n", params.Style.heading_("USAGE:"), params.ProgramName, params.ValuesString)
+	} else {
+
+		fmt.Fprintf(stream, "%s %s [ ... flags and options ... ]\n", params.Style.heading_("USAGE:"), params.ProgramName)
+	}
+	fmt.Fprintln(stream)
+
+	fmt.Fprintf(stream, "%s\n", params.Style.heading_("flags/options:"))
+	fmt.Fprintln(stream)
+
+	for _, section := range params.Sections {
+
+		if 0 != len(section.Title) {
+
+			fmt.Fprintf(stream, "\t%s\n", params.Style.heading_(section.Title+":"))
+			fmt.Fprintln(stream)
+		}
+
+		for i := range section.Specifications {
+
+			show_usage_specification_(stream, &section.Specifications[i], params.Width, params.Style)
+		}
+	}
+
+	show_usage_values_(stream, params.Values, params.Width, params.Style)
+
+	show_usage_epilog_(stream, params)
+}
+
+// Writes the version, in the form of that of [clasp.ShowVersion].
+func show_version_(params usage_params_) {
+
+	fmt.Fprintf(params.Stream, "%s %s\n", params.ProgramName, version_string_(params.Version, params.VersionPrefix))
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
@@ -0,0 +1,182 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"fmt"
+	"io"
+	"regexp"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * types
+ */
+
+// Type of validator function that may be specified to [ValidatedOption]
+// and [ValueSpecification.SetValidator], which returns a non-nil error if
+// the value is not valid.
+type ValidatorFunc func(value string) error
+
+// Type of validator function that may be specified to
+// [Climate.AddResultValidator], which returns a non-nil error if the
+// result, as a whole, is not valid.
+type ResultValidatorFunc func(result Result) error
+
+const (
+	_libCLImate_Validator = "_libCLImate_Validator_F73BB1C0_92D7_4cd5_9C36_DB672290CBE7"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Obtains the validator of the (validated) specification with the given
+// name, or false if there is none.
+func validator_of_(name string, specs []*clasp.Specification) (ValidatorFunc, bool) {
+
+	for _, spec := range specs {
+
+		if spec.Name == name {
+
+			if validator, ok := spec.Extras[_libCLImate_Validator].(ValidatorFunc); ok {
+
+				return validator, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// Invokes the given validator, returning an [*InvalidValueError] if it
+// fails.
+func validate_value_(name, value string, validator ValidatorFunc) error {
+
+	if err := validator(value); err != nil {
+
+		return &InvalidValueError{
+
+			Name:   name,
+			Value:  value,
+			Reason: "is invalid: " + err.Error(),
+			Err:    err,
+		}
+	}
+
+	return nil
+}
+
+// Verifies that the value of each validated option satisfies its
+// validator.
+func (result Result) validateOptionValidators(stream io.Writer) bool {
+
+	for _, option := range result.Options {
+
+		if validator, is_validated := validator_of_(option.ResolvedName, result.specifications); is_validated {
+
+			if err := validate_value_(option.ResolvedName, option.Value, validator); err != nil {
+
+				fmt.Fprintf(stream, "%s %v%s\n", result.prefix_(stream), err, uhs_(result.usageHelpSuffix))
+
+				result.exiter.Exit(1)
+
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Invokes each result validator, reporting the first failure.
+func (result Result) validateResultValidators(stream io.Writer) bool {
+
+	for _, validator := range result.resultValidators {
+
+		if err := validator(result); err != nil {
+
+			fmt.Fprintf(stream, "%s %v%s\n", result.prefix_(stream), err, uhs_(result.usageHelpSuffix))
+
+			result.exiter.Exit(1)
+
+			return false
+		}
+	}
+
+	return true
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Associates the given validator with the given option specification,
+// returning the validated specification, which may then be passed to
+// [Climate.AddOption] (or [OptionVar], or [PathOption]), as in:
+//
+//	cl.AddOption(libclimate.ValidatedOption(clasp.Option("--id").SetHelp("Specifies the id"), libclimate.MatchesPattern(`^[a-z]+$`)))
+//
+// The validator is invoked, for each occurrence of the option, by
+// [Result.Verify], which reports any failure in the form
+// "<name> '<value>' is invalid: <error>".
+func ValidatedOption(option clasp.Specification, validator ValidatorFunc) clasp.Specification {
+
+	return option.SetExtra(_libCLImate_Validator, validator)
+}
+
+// Adds a validator that is invoked, with the whole result, by
+// [Result.Verify] after all other verification has succeeded, allowing
+// for rules that concern more than one flag/option/value, as in:
+//
+//	cl.AddResultValidator(func(r libclimate.Result) error {
+//
+//		start, _, _ := libclimate.OptionValue[int](r, "--start")
+//		end, _, _ := libclimate.OptionValue[int](r, "--end")
+//
+//		if start > end {
+//
+//			return errors.New("--start must not be after --end")
+//		}
+//
+//		return nil
+//	})
+//
+// Validators are invoked in the order in which they are added, and the
+// first failure is reported in the same manner as by [Climate.Abort].
+func (cl *Climate) AddResultValidator(validator ResultValidatorFunc) {
+
+	cl.resultValidators = append(cl.resultValidators, validator)
+}
+
+// Creates a validator that requires the value to match the given regular
+// expression.
+func MatchesRegexp(re *regexp.Regexp) ValidatorFunc {
+
+	return func(value string) error {
+
+		if !re.MatchString(value) {
+
+			return fmt.Errorf("does not match pattern '%s'", re.String())
+		}
+
+		return nil
+	}
+}
+
+// Creates a validator that requires the value to match the given regular
+// expression pattern, which must be valid (as by [regexp.MustCompile]).
+func MatchesPattern(pattern string) ValidatorFunc {
+
+	return MatchesRegexp(regexp.MustCompile(pattern))
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
@@ -0,0 +1,185 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"errors"
+	"regexp"
+	"testing"
+)
+
+func Test_ValidatedOption_VALID(t *testing.T) {
+
+	var id string
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddOption(libclimate.OptionVar(&id, libclimate.ValidatedOption(clasp.Option("--id").SetHelp("Specifies the id"), libclimate.MatchesPattern(`^[a-z]+$`))))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--id=abc"}, stm, exiter)
+
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+	require.Equal(t, "abc", id)
+}
+
+func Test_ValidatedOption_PATTERN_NOT_MATCHED(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddOption(libclimate.ValidatedOption(clasp.Option("--id").SetHelp("Specifies the id"), libclimate.MatchesRegexp(regexp.MustCompile(`^[a-z]+$`))))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--id=ABC"}, stm, exiter, libclimate.ParseFlag_DontCheckUnused)
+
+	require.Equal(t, "myapp: --id 'ABC' is invalid: does not match pattern '^[a-z]+$'; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_ValidatedOption_CUSTOM(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddOption(libclimate.ValidatedOption(clasp.Option("--level").SetHelp("Specifies the level"), func(value string) error {
+
+			if "high" == value || "low" == value {
+
+				return nil
+			}
+
+			return errors.New("must be high or low")
+		}), libclimate.AliasFlag_Single)
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--level=low", "--level=medium"}, stm, exiter, libclimate.ParseFlag_DontCheckUnused)
+
+	require.Equal(t, "myapp: --level specified more than once; use --help for usage\n", stm.String())
+
+	stm.Reset()
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--level=medium"}, stm, exiter, libclimate.ParseFlag_DontCheckUnused)
+
+	require.Equal(t, "myapp: --level 'medium' is invalid: must be high or low; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_ValueValidator_PATTERN(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddValue(libclimate.Value("version").SetValidator(libclimate.MatchesPattern(`^\d+\.\d+$`)))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "1.x"}, stm, exiter)
+
+	require.Equal(t, "myapp: version '1.x' is invalid: does not match pattern '^\\d+\\.\\d+$'; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func result_validator_climate_() *libclimate.Climate {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.UsageHelpSuffix = ":"
+
+		cl.AddOption(clasp.Option("--start").SetHelp("Specifies the start"))
+		cl.AddOption(clasp.Option("--end").SetHelp("Specifies the end"))
+
+		cl.AddResultValidator(func(r libclimate.Result) error {
+
+			start, _, _ := libclimate.OptionValue[int](r, "--start")
+			end, _, _ := libclimate.OptionValue[int](r, "--end")
+
+			if start > end {
+
+				return errors.New("--start must not be after --end")
+			}
+
+			return nil
+		})
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	return climate
+}
+
+func Test_ResultValidator_PASSES(t *testing.T) {
+
+	climate := result_validator_climate_()
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--start=1", "--end=2"}, stm, exiter, libclimate.ParseFlag_DontCheckUnused)
+
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+}
+
+func Test_ResultValidator_FAILS(t *testing.T) {
+
+	climate := result_validator_climate_()
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--start=3", "--end=2"}, stm, exiter, libclimate.ParseFlag_DontCheckUnused)
+
+	require.Equal(t, "myapp: --start must not be after --end; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_ResultValidator_NOT_INVOKED_AFTER_BUILTIN_FAILURE(t *testing.T) {
+
+	invoked := false
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ValuesConstraint = []int{1}
+
+		cl.AddResultValidator(func(r libclimate.Result) error {
+
+			invoked = true
+
+			return nil
+		})
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp"}, stm, exiter)
+
+	require.Equal(t, 1, exiter.ExitCode)
+	require.False(t, invoked)
+}
@@ -0,0 +1,369 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * types
+ */
+
+// Type of a (positional) value, as specified by [ValueSpecification.SetType].
+type ValueType int
+
+// Structure describing a (positional) value, created by [Value] and added
+// via [Climate.AddValue].
+type ValueSpecification struct {
+	Name      string        // The name of the value, e.g. "input-file".
+	Help      string        // The help for the value.
+	Type      ValueType     // The type of the value, against which it is verified by [Result.Verify].
+	Optional  bool          // Whether the value is optional.
+	Variadic  bool          // Whether the value may be specified any number of times (which must be the last).
+	Validator ValidatorFunc // An optional validator function, which is invoked by [Result.Verify].
+	IsPath    bool          // Whether the value is a path, as specified by [ValueSpecification.SetPath].
+	PathFlags PathFlag      // The path flags, if the value is a path.
+}
+
+const (
+	ValueType_String   ValueType = iota // Any string.
+	ValueType_Integer                   // An integer.
+	ValueType_Number                    // A (floating-point) number.
+	ValueType_Duration                  // A duration, as parsed by [time.ParseDuration].
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Obtains the specification corresponding to the value at the given
+// index, or nil if there is none.
+func value_specification_for_index_(specs []*ValueSpecification, index int) *ValueSpecification {
+
+	if index < len(specs) {
+
+		return specs[index]
+	}
+
+	if 0 != len(specs) && specs[len(specs)-1].Variadic {
+
+		return specs[len(specs)-1]
+	}
+
+	return nil
+}
+
+// Obtains the value names, the values constraint, and the values string,
+// derived from the given value specifications.
+func derive_values_(specs []*ValueSpecification) (names []string, constraint []int, valuesString string) {
+
+	var min, max int
+	var required []string
+	var optional []string
+
+	for _, spec := range specs {
+
+		names = append(names, spec.Name)
+
+		var s string
+		if spec.Variadic {
+			s = fmt.Sprintf("<%s> ...", spec.Name)
+		} else {
+			s = fmt.Sprintf("<%s>", spec.Name)
+		}
+
+		if spec.Optional {
+
+			optional = append(optional, s)
+		} else {
+
+			required = append(required, s)
+
+			min++
+		}
+
+		if spec.Variadic {
+
+			max = -1
+		} else if max >= 0 {
+
+			max++
+		}
+	}
+
+	constraint = []int{min, max}
+
+	// The optional values are nested, as in "[ <a> [ <b> ]]"
+
+	var optionalString string
+
+	for i := len(optional) - 1; i >= 0; i-- {
+
+		if 0 == len(optionalString) {
+
+			optionalString = fmt.Sprintf("[ %s ]", optional[i])
+		} else {
+
+			optionalString = fmt.Sprintf("[ %s %s]", optional[i], optionalString)
+		}
+	}
+
+	if 0 != len(optionalString) {
+
+		required = append(required, optionalString)
+	}
+
+	valuesString = strings.Join(required, " ")
+
+	return
+}
+
+// Obtains (copies of) the given value specifications.
+func value_specifications_(specs []*ValueSpecification) (r []ValueSpecification) {
+
+	for _, spec := range specs {
+
+		r = append(r, *spec)
+	}
+
+	return
+}
+
+// Obtains the value names, the values constraint, and the values string,
+// each as specified explicitly or, if not, derived from the value
+// specifications.
+func (cl Climate) values_() (valueNames []string, valuesConstraint []int, valuesString string) {
+
+	valueNames, valuesConstraint, valuesString = cl.ValueNames, cl.ValuesConstraint, cl.ValuesString
+
+	if 0 != len(cl.ValueSpecifications) {
+
+		names, constraint, s := derive_values_(cl.ValueSpecifications)
+
+		if 0 == len(valueNames) {
+
+			valueNames = names
+		}
+		if 0 == len(valuesConstraint) {
+
+			valuesConstraint = constraint
+		}
+		if 0 == len(valuesString) {
+
+			valuesString = s
+		}
+	}
+
+	return
+}
+
+// Verifies the given value according to its specification, returning an
+// [*InvalidValueError] if it is not valid.
+func verify_value_(spec *ValueSpecification, value string) error {
+
+	var err error
+
+	switch spec.Type {
+
+	case ValueType_Integer:
+
+		_, err = convert_value_[int64](spec.Name, value)
+	case ValueType_Number:
+
+		_, err = convert_value_[float64](spec.Name, value)
+	case ValueType_Duration:
+
+		_, err = convert_value_[time.Duration](spec.Name, value)
+	}
+
+	if err == nil && spec.IsPath {
+
+		err = check_path_(spec.Name, value, spec.PathFlags)
+	}
+
+	if err == nil && spec.Validator != nil {
+
+		err = validate_value_(spec.Name, value, spec.Validator)
+	}
+
+	return err
+}
+
+// Shows the values section of the usage.
+func show_usage_values_(stream io.Writer, specs []ValueSpecification, width int, style style_) {
+
+	if 0 == len(specs) {
+
+		return
+	}
+
+	fmt.Fprintf(stream, "%s\n", style.heading_("values:"))
+	fmt.Fprintln(stream)
+
+	for _, spec := range specs {
+
+		if spec.Variadic {
+
+			fmt.Fprintf(stream, "\t%s ...\n", style.placeholder_("<"+spec.Name+">"))
+		} else {
+
+			fmt.Fprintf(stream, "\t%s\n", style.placeholder_("<"+spec.Name+">"))
+		}
+
+		help := spec.Help
+
+		if spec.Optional {
+
+			help += " (optional)"
+		}
+
+		if help = strings.TrimSpace(help); 0 != len(help) {
+
+			show_usage_wrapped_(stream, usageHelpIndentation_, help, width)
+		}
+
+		fmt.Fprintln(stream)
+	}
+}
+
+// Expands each value that is specified as a path.
+func expand_path_values_(values []*clasp.Argument, specs []*ValueSpecification) {
+
+	for i, value := range values {
+
+		if spec := value_specification_for_index_(specs, i); spec != nil && spec.IsPath {
+
+			value.Value = expand_path_(value.Value, spec.PathFlags)
+		}
+	}
+}
+
+// Verifies each value according to its value specification, if any.
+func (result Result) validateValueSpecifications(stream io.Writer) bool {
+
+	for i, value := range result.Values {
+
+		if spec := value_specification_for_index_(result.valueSpecs, i); spec != nil {
+
+			if err := verify_value_(spec, value.Value); err != nil {
+
+				fmt.Fprintf(stream, "%s %v%s\n", result.prefix_(stream), err, uhs_(result.usageHelpSuffix))
+
+				result.exiter.Exit(1)
+
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Creates a value specification with the given name.
+func Value(name string) ValueSpecification {
+
+	return ValueSpecification{
+
+		Name: name,
+	}
+}
+
+// Sets the help.
+func (spec ValueSpecification) SetHelp(help string) ValueSpecification {
+
+	spec.Help = help
+
+	return spec
+}
+
+// Sets the type.
+func (spec ValueSpecification) SetType(valueType ValueType) ValueSpecification {
+
+	spec.Type = valueType
+
+	return spec
+}
+
+// Marks the value as optional.
+func (spec ValueSpecification) SetOptional() ValueSpecification {
+
+	spec.Optional = true
+
+	return spec
+}
+
+// Marks the value as variadic, i.e. it may be specified any number of
+// times (including none, if also optional).
+func (spec ValueSpecification) SetVariadic() ValueSpecification {
+
+	spec.Variadic = true
+
+	return spec
+}
+
+// Sets the validator function.
+func (spec ValueSpecification) SetValidator(validator ValidatorFunc) ValueSpecification {
+
+	spec.Validator = validator
+
+	return spec
+}
+
+// Adds a (copy of the) value specification to the Climate instance.
+//
+// Value specifications are matched to values in the order in which they
+// are added. Unless explicitly specified, the fields
+// [Climate.ValueNames], [Climate.ValuesConstraint], and
+// [Climate.ValuesString] are derived from the value specifications.
+func (cl *Climate) AddValue(value ValueSpecification) {
+
+	cl.ValueSpecifications = append(cl.ValueSpecifications, &value)
+}
+
+// Looks for the value corresponding to the value specification with the
+// given name and returns it and the value true if found; if not, returns
+// nil and false. If the specification is variadic, the first of its values
+// is returned.
+func (result Result) Value(name string) (*clasp.Argument, bool) {
+
+	if values := result.ValuesNamed(name); 0 != len(values) {
+
+		return values[0], true
+	}
+
+	return nil, false
+}
+
+// Obtains all values corresponding to the value specification with the
+// given name.
+func (result Result) ValuesNamed(name string) (values []*clasp.Argument) {
+
+	for i, value := range result.Values {
+
+		if spec := value_specification_for_index_(result.valueSpecs, i); spec != nil && spec.Name == name {
+
+			values = append(values, value)
+		}
+	}
+
+	return
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
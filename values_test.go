@@ -0,0 +1,170 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func values_climate_(t *testing.T) *libclimate.Climate {
+
+	climate, err := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+
+		cl.AddValue(libclimate.Value("input-file").SetHelp("The input file"))
+		cl.AddValue(libclimate.Value("count").SetHelp("The number of items").SetType(libclimate.ValueType_Integer).SetOptional())
+		cl.AddValue(libclimate.Value("tags").SetHelp("Any tags").SetOptional().SetVariadic().SetValidator(func(value string) error {
+
+			if strings.ContainsAny(value, " \t") {
+
+				return errors.New("contains whitespace")
+			}
+
+			return nil
+		}))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	require.Nil(t, err)
+
+	return climate
+}
+
+func Test_Values_LOOKUP(t *testing.T) {
+
+	climate := values_climate_(t)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r, _ := climate.ParseAndVerify([]string{"bin/myapp", "in.txt", "3", "a", "b"}, stm, exiter)
+
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+
+	input, found := r.Value("input-file")
+
+	require.True(t, found)
+	require.Equal(t, "in.txt", input.Value)
+
+	count, found := r.Value("count")
+
+	require.True(t, found)
+	require.Equal(t, "3", count.Value)
+
+	tags := r.ValuesNamed("tags")
+
+	require.Equal(t, 2, len(tags))
+	require.Equal(t, "a", tags[0].Value)
+	require.Equal(t, "b", tags[1].Value)
+
+	_, found = r.Value("unknown")
+
+	require.False(t, found)
+}
+
+func Test_Values_OPTIONAL_NOT_SPECIFIED(t *testing.T) {
+
+	climate := values_climate_(t)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r, _ := climate.ParseAndVerify([]string{"bin/myapp", "in.txt"}, stm, exiter)
+
+	require.Equal(t, -1, exiter.ExitCode)
+
+	_, found := r.Value("count")
+
+	require.False(t, found)
+	require.Empty(t, r.ValuesNamed("tags"))
+}
+
+func Test_Values_REQUIRED_NOT_SPECIFIED(t *testing.T) {
+
+	climate := values_climate_(t)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp"}, stm, exiter)
+
+	require.Equal(t, "myapp: input-file not specified; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_Values_INVALID_TYPE(t *testing.T) {
+
+	climate := values_climate_(t)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "in.txt", "three"}, stm, exiter)
+
+	require.Equal(t, "myapp: count 'three' is not a valid integer; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_Values_VALIDATOR_FAILS(t *testing.T) {
+
+	climate := values_climate_(t)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "in.txt", "3", "ok", "not ok"}, stm, exiter)
+
+	require.Equal(t, "myapp: tags 'not ok' is invalid: contains whitespace; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_Values_USAGE(t *testing.T) {
+
+	climate := values_climate_(t)
+
+	lines := usage_lines_(t, climate)
+
+	require.Contains(t, lines, "USAGE: myapp [ ... flags and options ... ] <input-file> [ <count> [ <tags> ... ]]")
+	require.Contains(t, lines, "values:")
+	require.Contains(t, lines, "\t<input-file>")
+	require.Contains(t, lines, "\t\tThe input file")
+	require.Contains(t, lines, "\t<count>")
+	require.Contains(t, lines, "\t\tThe number of items (optional)")
+	require.Contains(t, lines, "\t<tags> ...")
+	require.Contains(t, lines, "\t\tAny tags (optional)")
+}
+
+func Test_Values_EXPLICIT_VALUES_STRING(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+		cl.ValuesString = "<source> <target>"
+
+		cl.AddValue(libclimate.Value("source"))
+		cl.AddValue(libclimate.Value("target"))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	lines := usage_lines_(t, climate)
+
+	require.Contains(t, lines, "USAGE: myapp [ ... flags and options ... ] <source> <target>")
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "a", "b", "c"}, stm, exiter)
+
+	require.Equal(t, "myapp: too many values; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
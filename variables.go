@@ -0,0 +1,141 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	clasp "github.com/synesissoftware/CLASP.Go"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * types
+ */
+
+// Binds a flag/option specification to a variable.
+type binder_ interface {
+	bind_(name, value string) error
+}
+
+type option_binder_[T any] struct {
+	receiver *T
+}
+
+func (b option_binder_[T]) bind_(name, value string) error {
+
+	v, err := convert_value_[T](name, value)
+	if err != nil {
+
+		return err
+	}
+
+	// For the slice types, each occurrence is accumulated
+
+	switch r := any(b.receiver).(type) {
+
+	case *[]string:
+
+		*r = append(*r, any(v).([]string)...)
+	case *[]int:
+
+		*r = append(*r, any(v).([]int)...)
+	default:
+
+		*b.receiver = v
+	}
+
+	return nil
+}
+
+type flag_binder_ struct {
+	receiver *bool
+}
+
+func (b flag_binder_) bind_(name, value string) error {
+
+	*b.receiver = true
+
+	return nil
+}
+
+func (b flag_binder_) unbind_() {
+
+	*b.receiver = false
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Binds the value of the given argument to the variable, if any, bound to
+// its specification, or, if the specification is the negation of a flag
+// that is bound, unbinds it.
+func bind_argument_(argument *clasp.Argument, spec *clasp.Specification, specs []*clasp.Specification) error {
+
+	if b, ok := spec.Extras[_libCLImate_Binder].(binder_); ok {
+
+		argument.Use()
+
+		return b.bind_(spec.Name, argument.Value)
+	}
+
+	if name, is_negation := negation_of_(spec); is_negation {
+
+		for _, s := range specs {
+
+			if s.Name == name {
+
+				if b, ok := s.Extras[_libCLImate_Binder].(flag_binder_); ok {
+
+					b.unbind_()
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+/* /////////////////////////////////////////////////////////////////////////
+ * API functions
+ */
+
+// Binds the given option specification to the variable pointed to by
+// receiver, returning the bound specification, which may then be passed
+// to [Climate.AddOption], as in:
+//
+//	var port int
+//
+//	cl.AddOption(libclimate.OptionVar(&port, clasp.Option("--port").SetHelp("Specifies the port")))
+//
+// The option value is converted to T - which may be any type supported by
+// [OptionValue] - during [Climate.Parse], and any conversion failure is
+// reported by [Result.Verify]. For the slice types, the values of all
+// occurrences of the option are accumulated.
+func OptionVar[T any](receiver *T, option clasp.Specification) clasp.Specification {
+
+	return option.SetExtra(_libCLImate_Binder, option_binder_[T]{receiver: receiver})
+}
+
+// Binds the given flag specification to the boolean variable pointed to by
+// receiver, returning the bound specification, which may then be passed
+// to [Climate.AddFlag], as in:
+//
+//	var debug bool
+//
+//	cl.AddFlag(libclimate.FlagVar(&debug, clasp.Flag("--debug").SetHelp("Runs in debug mode")))
+//
+// The variable is set to true if the flag is specified, or, if the flag is
+// qualified by AliasFlag_Negatable, set to false if its negation is the
+// last specified.
+func FlagVar(receiver *bool, flag clasp.Specification) clasp.Specification {
+
+	return flag.SetExtra(_libCLImate_Binder, flag_binder_{receiver: receiver})
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
@@ -0,0 +1,121 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"testing"
+	"time"
+)
+
+func Test_OptionVar_AND_FlagVar_1(t *testing.T) {
+
+	var port int = 80
+	var timeout time.Duration
+	var name string
+	var tags []string
+	var debug bool
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddOption(libclimate.OptionVar(&port, clasp.Option("--port").SetHelp("Specifies the port")))
+		cl.AddOption(libclimate.OptionVar(&timeout, clasp.Option("--timeout").SetHelp("Specifies the timeout").SetDefaultValue("5s")))
+		cl.AddOption(libclimate.OptionVar(&name, clasp.Option("--name").SetHelp("Specifies the name")))
+		cl.AddOption(libclimate.OptionVar(&tags, clasp.Option("--tag").SetHelp("Specifies a tag")), libclimate.AliasFlag_Repeatable)
+		cl.AddFlag(libclimate.FlagVar(&debug, clasp.Flag("--debug").SetHelp("Runs in debug mode")))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--port=8080", "--tag=a", "--tag=b,c", "--debug"}, stm, exiter)
+
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+
+	require.Equal(t, 8080, port)
+	require.Equal(t, 5*time.Second, timeout)
+	require.Equal(t, "", name)
+	require.Equal(t, []string{"a", "b", "c"}, tags)
+	require.True(t, debug)
+}
+
+func Test_OptionVar_NOT_SPECIFIED(t *testing.T) {
+
+	var port int = 80
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddOption(libclimate.OptionVar(&port, clasp.Option("--port").SetHelp("Specifies the port")))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp"}, stm, exiter)
+
+	require.Equal(t, 80, port)
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+}
+
+func Test_OptionVar_INVALID(t *testing.T) {
+
+	var port int
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddOption(libclimate.OptionVar(&port, clasp.Option("--port").SetHelp("Specifies the port")))
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	r, err := climate.Parse([]string{"bin/myapp", "--port=http"}, stm, exiter)
+
+	require.Nil(t, err)
+	require.Equal(t, "", stm.String())
+
+	r.Verify()
+
+	require.Equal(t, "myapp: --port 'http' is not a valid integer; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+}
+
+func Test_FlagVar_Negatable(t *testing.T) {
+
+	var color bool
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.AddFlag(libclimate.FlagVar(&color, clasp.Flag("--color").SetHelp("Uses colour")), libclimate.AliasFlag_Negatable)
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	color = true
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--no-color"}, stm, exiter)
+
+	require.False(t, color)
+
+	_, _ = climate.ParseAndVerify([]string{"bin/myapp", "--no-color", "--color"}, stm, exiter)
+
+	require.True(t, color)
+	require.Equal(t, "", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+}
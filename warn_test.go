@@ -0,0 +1,111 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+	"github.com/synesissoftware/libCLImate.Go/internal"
+
+	"github.com/stretchr/testify/require"
+
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func Test_Warn_1(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	climate.Warn("Some-condition", errors.New("SOME-REASON"), stm, exiter)
+
+	require.Equal(t, "myapp: warning: Some-condition: SOME-REASON\n", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+	require.Equal(t, 1, climate.NumWarnings())
+}
+
+func Test_Warn_2(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	require.Equal(t, 0, climate.NumWarnings())
+
+	climate.Warn("first", nil, stm, exiter)
+	climate.Warn("second", nil, stm, exiter)
+
+	require.Equal(t, "myapp: warning: first\nmyapp: warning: second\n", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+	require.Equal(t, 2, climate.NumWarnings())
+}
+
+func Test_Warnf_1(t *testing.T) {
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure, stm, exiter)
+
+	climate.Warnf("option '%s' is deprecated", "--colour")
+
+	require.Equal(t, "myapp: warning: option '--colour' is deprecated\n", stm.String())
+	require.Equal(t, -1, exiter.ExitCode)
+	require.Equal(t, 1, climate.NumWarnings())
+}
+
+func Test_Warn_WITH_WarningsAreErrors(t *testing.T) {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure, libclimate.InitFlag_WarningsAreErrors)
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	climate.Warn("Some-condition", nil, stm, exiter)
+
+	require.Equal(t, "myapp: Some-condition; use --help for usage\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+	require.Equal(t, 1, climate.NumWarnings())
+}
+
+func Test_Abortf_1(t *testing.T) {
+
+	stm := new(bytes.Buffer)
+	exiter := &internal.CaptureExiter{ExitCode: -1}
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.ProgramName = "myapp"
+		cl.UsageHelpSuffix = ""
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure, stm, exiter)
+
+	climate.Abortf("cannot open '%s'", "file.txt")
+
+	require.Equal(t, "myapp: cannot open 'file.txt'\n", stm.String())
+	require.Equal(t, 1, exiter.ExitCode)
+	require.Equal(t, 0, climate.NumWarnings())
+}
@@ -0,0 +1,154 @@
+// Copyright 2019-2026, Matthew Wilson and Synesis Information Systems. All
+// rights reserved. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+ * Created: 19th October 2026
+ * Updated: 19th October 2026
+ */
+
+package libclimate
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * constants
+ */
+
+const (
+	UsageWidth_Default = 80 // The width to which the usage is wrapped when the output stream is not a terminal.
+	UsageWidth_NoWrap  = -1 // Specifies, as [Climate.UsageWidth], that the usage is not wrapped.
+)
+
+const (
+	usageTabWidth_        = 8  // The width assumed for each tab of indentation.
+	usageMinimumColumns_  = 20 // The minimum number of columns of help text on each line, however narrow the width.
+	usageHelpIndentation_ = "\t\t"
+)
+
+/* /////////////////////////////////////////////////////////////////////////
+ * helper functions
+ */
+
+// Obtains the width to which the usage is written to the given stream:
+// the given width, if specified; otherwise, if the stream is a terminal,
+// that specified by the COLUMNS environment variable, if valid, or else the
+// width of the terminal; otherwise UsageWidth_Default. (COLUMNS is not
+// consulted when the stream is not a terminal, since it describes the
+// terminal, not, say, the file or pipe to which the usage is written.)
+func usage_width_(width int, stream io.Writer) int {
+
+	if 0 != width {
+
+		return width
+	}
+
+	if f, ok := stream.(*os.File); ok {
+
+		if columns, ok := terminal_columns_(f.Fd()); ok {
+
+			if n, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && 0 < n {
+
+				return n
+			}
+
+			if 0 < columns {
+
+				return columns
+			}
+		}
+	}
+
+	return UsageWidth_Default
+}
+
+// Obtains the display width of the given indentation, assuming tabs of
+// width usageTabWidth_.
+func indentation_width_(indentation string) (n int) {
+
+	for _, r := range indentation {
+
+		if '\t' == r {
+
+			n += usageTabWidth_ - n%usageTabWidth_
+		} else {
+
+			n++
+		}
+	}
+
+	return
+}
+
+// Wraps the given text into lines of no more than the given number of
+// columns - other than any that comprise a single word that is longer -
+// breaking at whitespace and preserving any explicit line breaks. A
+// non-positive columns means no wrapping.
+func wrap_text_(text string, columns int) (lines []string) {
+
+	for _, paragraph := range strings.Split(text, "\n") {
+
+		if columns <= 0 || utf8.RuneCountInString(paragraph) <= columns {
+
+			lines = append(lines, paragraph)
+
+			continue
+		}
+
+		var line string
+
+		for _, word := range strings.Fields(paragraph) {
+
+			switch {
+
+			case 0 == len(line):
+
+				line = word
+			case utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) <= columns:
+
+				line += " " + word
+			default:
+
+				lines = append(lines, line)
+
+				line = word
+			}
+		}
+
+		lines = append(lines, line)
+	}
+
+	return
+}
+
+// Writes the given text at the given indentation, wrapped to the given
+// width (see [Climate.UsageWidth]), such that each line has the same
+// (hanging) indentation.
+func show_usage_wrapped_(stream io.Writer, indentation, text string, width int) {
+
+	columns := -1
+
+	if 0 < width {
+
+		columns = width - indentation_width_(indentation)
+
+		if columns < usageMinimumColumns_ {
+
+			columns = usageMinimumColumns_
+		}
+	}
+
+	for _, line := range wrap_text_(text, columns) {
+
+		fmt.Fprintf(stream, "%s%s\n", indentation, line)
+	}
+}
+
+/* ///////////////////////////// end of file //////////////////////////// */
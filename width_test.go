@@ -0,0 +1,95 @@
+package libclimate_test
+
+import (
+	libclimate "github.com/synesissoftware/libCLImate.Go"
+
+	clasp "github.com/synesissoftware/CLASP.Go"
+
+	"github.com/stretchr/testify/require"
+
+	"testing"
+)
+
+func width_climate_(width int) *libclimate.Climate {
+
+	climate, _ := libclimate.Init(func(cl *libclimate.Climate) (err error) {
+
+		cl.UsageWidth = width
+
+		cl.AddFlag(clasp.Flag("--debug").SetHelp("Runs in debug mode, in which all manner of diagnostic information is written to the standard error stream"))
+		cl.AddOption(clasp.Option("--level").SetHelp("Specifies the level").SetDefaultValue("1"))
+
+		cl.AddValue(libclimate.Value("input-file").SetHelp("The file from which all input is read, which must exist").SetOptional())
+
+		cl.AddExitStatus(2, "The input file could not be read, or contains invalid data")
+
+		return nil
+	}, libclimate.InitFlag_PanicOnFailure)
+
+	return climate
+}
+
+func Test_UsageWidth_EXPLICIT(t *testing.T) {
+
+	lines := usage_lines_(t, width_climate_(56))
+
+	require.Equal(t, []string{
+		"USAGE: myapp [ ... flags and options ... ] [ <input-file> ]",
+		"flags/options:",
+		"\t--help",
+		"\t\tShows this help and exits",
+		"\t--version",
+		"\t\tShows version information and exits",
+		"\t--debug",
+		"\t\tRuns in debug mode, in which all manner",
+		"\t\tof diagnostic information is written to",
+		"\t\tthe standard error stream",
+		"\t--level=<value>",
+		"\t\tSpecifies the level (default: 1)",
+		"values:",
+		"\t<input-file>",
+		"\t\tThe file from which all input is read,",
+		"\t\twhich must exist (optional)",
+		"exit status:",
+		"\t2",
+		"\t\tThe input file could not be read, or",
+		"\t\tcontains invalid data",
+	}, lines)
+}
+
+func Test_UsageWidth_NARROW(t *testing.T) {
+
+	lines := usage_lines_(t, width_climate_(10))
+
+	// at least usageMinimumColumns_ columns of help text
+
+	require.Contains(t, lines, "\t\tRuns in debug mode,")
+	require.Contains(t, lines, "\t\tin which all manner")
+}
+
+func Test_UsageWidth_NO_WRAP(t *testing.T) {
+
+	lines := usage_lines_(t, width_climate_(libclimate.UsageWidth_NoWrap))
+
+	require.Contains(t, lines, "\t\tRuns in debug mode, in which all manner of diagnostic information is written to the standard error stream")
+}
+
+func Test_UsageWidth_COLUMNS_NOT_TERMINAL(t *testing.T) {
+
+	t.Setenv("COLUMNS", "40")
+
+	lines := usage_lines_(t, width_climate_(0))
+
+	// COLUMNS is ignored, since the stream is not a terminal
+
+	require.Contains(t, lines, "\t\tRuns in debug mode, in which all manner of diagnostic")
+	require.Contains(t, lines, "\t\tinformation is written to the standard error stream")
+	require.Contains(t, lines, "\t\tThe input file could not be read, or contains invalid data")
+}
+
+func Test_UsageWidth_DEFAULT(t *testing.T) {
+
+	lines := usage_lines_(t, width_climate_(0))
+
+	require.Contains(t, lines, "\t\tThe input file could not be read, or contains invalid data")
+}